@@ -0,0 +1,182 @@
+package add
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+)
+
+const authDir = "auth"
+
+var (
+	errAuthType   = errors.New("please provide the type of the authentication using the \"-type\" option, one of apikey, basic or oauth")
+	errAuthExists = errors.New("main.go enables authentication already")
+	errAuthCMD    = errors.New("command line applications can not authenticate requests")
+)
+
+// authMethods are the methods of the app enabling authentication, an app can only use one of them.
+//
+//nolint:gochecknoglobals // methods checked before enabling authentication.
+var authMethods = []string{
+	"EnableBasicAuth", "EnableBasicAuthWithValidator", "EnableBasicAuthWithFunc",
+	"EnableAPIKeyAuth", "EnableAPIKeyAuthWithValidator", "EnableAPIKeyAuthWithFunc",
+	"EnableOAuth",
+}
+
+// authType is an authentication GoFr provides. Types with a template get a validator generated in the
+// auth package, which is passed to the app.
+type authType struct {
+	template string
+	code     string
+	env      []envVar
+}
+
+//nolint:gochecknoglobals // authentications supported by add auth, by their -type.
+var authTypes = map[string]authType{
+	"apikey": {
+		template: "apikey",
+		code:     "%[1]s.EnableAPIKeyAuthWithValidator(auth.ValidateAPIKey)",
+		env:      []envVar{{"API_KEYS", ""}},
+	},
+	"basic": {
+		template: "basic",
+		code:     "%[1]s.EnableBasicAuthWithValidator(auth.ValidateBasicAuth)",
+		env:      []envVar{{"BASIC_AUTH_USERNAME", ""}, {"BASIC_AUTH_PASSWORD", ""}},
+	},
+	"oauth": {
+		code: `jwksRefreshInterval, err := strconv.Atoi(%[1]s.Config.Get("OAUTH_JWKS_REFRESH_INTERVAL"))
+if err != nil {
+	%[1]s.Logger().Fatalf("invalid OAUTH_JWKS_REFRESH_INTERVAL: %%v", err)
+}
+
+%[1]s.EnableOAuth(%[1]s.Config.Get("OAUTH_JWKS_URL"), jwksRefreshInterval)`,
+		env: []envVar{{"OAUTH_JWKS_URL", "https://example.com/.well-known/jwks.json"}, {"OAUTH_JWKS_REFRESH_INTERVAL", "3600"}},
+	},
+}
+
+// Auth enables authentication of the requests to the app in main.go, e.g.
+//
+//	gofr add auth -type=apikey
+//
+// apikey and basic generate a validator checking the credentials against configs/.env in the auth package,
+// oauth validates the tokens with the keys of a JWKS endpoint. The variables are added to configs/.env, the
+// credentials without a value so that no request is accepted until they are set.
+func Auth(ctx *gofr.Context) (any, error) {
+	p, err := LoadProject(".")
+	if err != nil {
+		return nil, err
+	}
+
+	main, err := readMain(".")
+	if err != nil {
+		return nil, err
+	}
+
+	kind := strings.ToLower(ctx.Param("type"))
+
+	files, err := addAuth(p, main, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := addEnv(".", p, "Authentication of the requests, read in main.go and the auth package.", authTypes[kind].env)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := []File{main.file()}
+	if env != nil {
+		updated = append(updated, *env)
+	}
+
+	err = writeChanges(".", files, updated, ctx.Param("force") == "true")
+	if err != nil {
+		return nil, err
+	}
+
+	if unset := unsetEnv(p, authTypes[kind].env); len(unset) > 0 {
+		return fmt.Sprintf("Successfully enabled %s authentication\nNote: set %s in %s, all the requests are rejected "+
+			"until then", kind, strings.Join(unset, " and "), envFile), nil
+	}
+
+	return fmt.Sprintf("Successfully enabled %s authentication", kind), nil
+}
+
+// unsetEnv returns the keys of the variables without a default which configs/.env does not set either, the
+// credentials of apikey and basic which the user has to choose.
+func unsetEnv(p *Project, vars []envVar) []string {
+	var keys []string
+
+	for _, v := range vars {
+		if v.Value == "" && p.Env.Get(v.Key) == "" {
+			keys = append(keys, v.Key)
+		}
+	}
+
+	return keys
+}
+
+// addAuth enables the authentication of the kind in main, returning the generated validator and its test.
+func addAuth(p *Project, main *mainFile, kind string) ([]File, error) {
+	t, ok := authTypes[kind]
+	if !ok {
+		return nil, fmt.Errorf("%w, got %q", errAuthType, kind)
+	}
+
+	if p.CMD {
+		return nil, errAuthCMD
+	}
+
+	app, err := main.app()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, method := range authMethods {
+		exists, callErr := main.calls(method, nil)
+		if callErr != nil {
+			return nil, callErr
+		}
+
+		if exists {
+			return nil, fmt.Errorf("%w: %s.%s", errAuthExists, app, method)
+		}
+	}
+
+	var files []File
+
+	if t.template != "" {
+		files, err = authFiles(t.template)
+		if err != nil {
+			return nil, err
+		}
+
+		err = main.addImport("", p.Module+"/"+authDir)
+	} else {
+		err = main.addImport("", "strconv")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return files, main.insert(fmt.Sprintf(t.code, app))
+}
+
+func authFiles(name string) ([]File, error) {
+	var files []File
+
+	for _, file := range []string{name + ".go", name + "_test.go"} {
+		content, err := templates.ReadFile(path.Join("templates", "auth", file+".tmpl"))
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, File{Path: path.Join(authDir, file), Content: content})
+	}
+
+	return formatGo(files)
+}
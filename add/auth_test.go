@@ -0,0 +1,40 @@
+package add
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddAuth(t *testing.T) {
+	for _, kind := range []string{"apikey", "basic", "oauth"} {
+		t.Run(kind, func(t *testing.T) {
+			main := &mainFile{src: []byte(testMain)}
+
+			files, err := addAuth(testProject(t, ""), main, kind)
+			require.NoError(t, err)
+
+			for _, f := range append(files, main.file()) {
+				assertGolden(t, filepath.Join("testdata", "auth", kind, f.Path), f.Content)
+			}
+
+			_, err = addAuth(testProject(t, ""), main, "basic")
+
+			require.ErrorIs(t, err, errAuthExists)
+		})
+	}
+
+	_, err := addAuth(testProject(t, ""), &mainFile{src: []byte(testMain)}, "jwt")
+
+	require.ErrorIs(t, err, errAuthType)
+}
+
+func TestUnsetEnv(t *testing.T) {
+	p := testProject(t, "API_KEYS=secret\n")
+
+	assert.Empty(t, unsetEnv(p, authTypes["apikey"].env))
+	assert.Equal(t, []string{"BASIC_AUTH_USERNAME", "BASIC_AUTH_PASSWORD"}, unsetEnv(p, authTypes["basic"].env))
+	assert.Empty(t, unsetEnv(p, authTypes["oauth"].env))
+}
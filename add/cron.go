@@ -0,0 +1,326 @@
+package add
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"gofr.dev/pkg/gofr"
+)
+
+const jobDir = "job"
+
+var (
+	errCronName     = errors.New(`please provide the name of the cron job using the "-name" option`)
+	errCronSchedule = errors.New("invalid cron schedule")
+	errCronExists   = errors.New("main.go adds a cron job with the name already")
+	errCronCMD      = errors.New("command line applications can not run cron jobs")
+)
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+//nolint:gochecknoglobals // fields of a cron schedule, the seconds are optional.
+var cronFields = []cronField{
+	{"second", 0, 59}, {"minute", 0, 59}, {"hour", 0, 23}, {"day of month", 1, 31}, {"month", 1, 12}, {"day of week", 0, 6},
+}
+
+type cronData struct {
+	Name     string
+	Func     string
+	Schedule string
+}
+
+// cronJob is a cron job added to the app somewhere in the project.
+type cronJob struct {
+	Schedule string
+	Name     string
+	Func     string
+	// Pos is the file and line of the AddCronJob call, e.g. main.go:12.
+	Pos string
+}
+
+// Cron generates a job function with its test and adds it to the app in main.go, e.g.
+//
+//	gofr add cron -name=cleanup -schedule="*/5 * * * *"
+//
+// The schedule is validated like GoFr does it, in the five field format or with the seconds as sixth field.
+// With -list it shows the cron jobs the project adds to its app instead.
+func Cron(ctx *gofr.Context) (any, error) {
+	if ctx.Param("list") == "true" {
+		jobs, err := findCronJobs(".")
+		if err != nil {
+			return nil, err
+		}
+
+		return formatCronJobs(jobs), nil
+	}
+
+	p, err := LoadProject(".")
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := newCronData(ctx.Param("name"), ctx.Param("schedule"))
+	if err != nil {
+		return nil, err
+	}
+
+	main, err := readMain(".")
+	if err != nil {
+		return nil, err
+	}
+
+	err = registerCron(main, p, d)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := cronFiles(d)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeChanges(".", files, []File{main.file()}, ctx.Param("force") == "true")
+	if err != nil {
+		return nil, err
+	}
+
+	return fmt.Sprintf("Successfully added cron job %s scheduled %q", d.Name, d.Schedule), nil
+}
+
+func newCronData(name, schedule string) (*cronData, error) {
+	fn := exported(strings.Trim(nonIdentifier.ReplaceAllString(name, "_"), "_"))
+	if !token.IsIdentifier(fn) {
+		return nil, errCronName
+	}
+
+	schedule = strings.Join(strings.Fields(schedule), " ")
+
+	err := validateSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cronData{Name: name, Func: fn, Schedule: schedule}, nil
+}
+
+// validateSchedule checks every field of the schedule, a list of values, ranges and steps like 1,5-10/2,*/15.
+func validateSchedule(schedule string) error {
+	fields := strings.Fields(schedule)
+
+	switch len(fields) {
+	case len(cronFields) - 1:
+		fields = append([]string{"0"}, fields...)
+	case len(cronFields):
+	default:
+		return fmt.Errorf("%w %q, expected 5 fields, or 6 with the seconds first", errCronSchedule, schedule)
+	}
+
+	for i, field := range fields {
+		for _, part := range strings.Split(field, ",") {
+			if !cronFields[i].valid(part) {
+				return fmt.Errorf("%w %q, %q is not a valid %s", errCronSchedule, schedule, field, cronFields[i].name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (f cronField) valid(part string) bool {
+	value, step, hasStep := strings.Cut(part, "/")
+	if hasStep {
+		if n, err := strconv.Atoi(step); err != nil || n <= 0 || n > f.max {
+			return false
+		}
+	}
+
+	if value == "*" {
+		return true
+	}
+
+	from, to, isRange := strings.Cut(value, "-")
+	if !isRange {
+		to = from
+	}
+
+	lo, err := strconv.Atoi(from)
+	if err != nil {
+		return false
+	}
+
+	hi, err := strconv.Atoi(to)
+	if err != nil {
+		return false
+	}
+
+	return f.min <= lo && lo <= hi && hi <= f.max
+}
+
+func cronFiles(d *cronData) ([]File, error) {
+	file := snake(d.Func)
+
+	var files []File
+
+	for _, name := range []string{"job.go", "job_test.go"} {
+		content, err := templates.ReadFile(path.Join("templates", "cron", name+".tmpl"))
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, File{
+			Path:    path.Join(jobDir, file+strings.TrimPrefix(name, "job")),
+			Content: content,
+		})
+	}
+
+	files, err := renderFiles(d, "{{", "}}", files)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatGo(files)
+}
+
+// registerCron adds the job to the app in main, unless main adds a job with its name already.
+func registerCron(main *mainFile, p *Project, d *cronData) error {
+	if p.CMD {
+		return errCronCMD
+	}
+
+	app, err := main.app()
+	if err != nil {
+		return err
+	}
+
+	exists, err := main.calls("AddCronJob", func(args []ast.Expr) bool {
+		name, ok := stringArg(args, 1)
+
+		return ok && name == d.Name
+	})
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return fmt.Errorf("%w: %s", errCronExists, d.Name)
+	}
+
+	err = main.addImport("", p.Module+"/"+jobDir)
+	if err != nil {
+		return err
+	}
+
+	return main.insert(fmt.Sprintf("%s.AddCronJob(%q, %q, %s.%s)", app, d.Schedule, d.Name, jobDir, d.Func))
+}
+
+// findCronJobs returns the cron jobs added with AddCronJob in the Go files below dir, skipping tests,
+// vendored and hidden directories. Arguments which are not literals are shown as they are written.
+func findCronJobs(dir string) ([]cronJob, error) {
+	var jobs []cronJob
+
+	err := filepath.WalkDir(dir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if name := entry.Name(); p != dir && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !strings.HasSuffix(p, ".go") || strings.HasSuffix(p, "_test.go") {
+			return nil
+		}
+
+		found, err := fileCronJobs(dir, p)
+		jobs = append(jobs, found...)
+
+		return err
+	})
+
+	return jobs, err
+}
+
+func fileCronJobs(dir, file string) ([]cronJob, error) {
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := filepath.Rel(dir, file)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []cronJob
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 3 {
+			return true
+		}
+
+		if sel, isSel := call.Fun.(*ast.SelectorExpr); !isSel || sel.Sel.Name != "AddCronJob" {
+			return true
+		}
+
+		jobs = append(jobs, cronJob{
+			Schedule: argString(call.Args, 0),
+			Name:     argString(call.Args, 1),
+			Func:     types.ExprString(call.Args[2]),
+			Pos:      fmt.Sprintf("%s:%d", filepath.ToSlash(rel), fset.Position(call.Pos()).Line),
+		})
+
+		return true
+	})
+
+	return jobs, nil
+}
+
+// argString returns the value of a string literal argument, or the expression of any other argument.
+func argString(args []ast.Expr, i int) string {
+	if value, ok := stringArg(args, i); ok {
+		return value
+	}
+
+	return types.ExprString(args[i])
+}
+
+func formatCronJobs(jobs []cronJob) string {
+	if len(jobs) == 0 {
+		return "No cron jobs found"
+	}
+
+	var buf bytes.Buffer
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "SCHEDULE\tNAME\tFUNCTION\tLOCATION")
+
+	for _, j := range jobs {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", j.Schedule, j.Name, j.Func, j.Pos)
+	}
+
+	w.Flush()
+
+	return strings.TrimRight(buf.String(), "\n")
+}
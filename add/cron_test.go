@@ -0,0 +1,95 @@
+package add
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchedule(t *testing.T) {
+	tests := []struct {
+		schedule string
+		valid    bool
+	}{
+		{"*/5 * * * *", true},
+		{"0 0 1,15 * 1-5", true},
+		{"*/30 0-30/10 9-17 * * 0", true},
+		{"0 0 * *", false},
+		{"60 * * * *", false},
+		{"* 24 * * *", false},
+		{"* * 0 * *", false},
+		{"* * * 1-13 *", false},
+		{"* * * * 7", false},
+		{"*/0 * * * *", false},
+		{"5-1 * * * *", false},
+		{"@daily", false},
+		{"* * * JAN *", false},
+	}
+
+	for i, tc := range tests {
+		err := validateSchedule(tc.schedule)
+
+		if tc.valid {
+			require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.schedule)
+		} else {
+			require.ErrorIs(t, err, errCronSchedule, "TEST[%d], Failed.\n%s", i, tc.schedule)
+		}
+	}
+}
+
+func TestAddCron(t *testing.T) {
+	d, err := newCronData("send-reports", " 0  9 * * 1")
+	require.NoError(t, err)
+	assert.Equal(t, "0 9 * * 1", d.Schedule)
+
+	files, err := cronFiles(d)
+	require.NoError(t, err)
+
+	for _, f := range files {
+		assertGolden(t, filepath.Join("testdata", "cron", f.Path), f.Content)
+	}
+
+	main := &mainFile{src: []byte(testMain)}
+
+	require.NoError(t, registerCron(main, testProject(t, ""), d))
+
+	assertGolden(t, filepath.Join("testdata", "cron", "main.go"), main.src)
+
+	err = registerCron(main, testProject(t, ""), d)
+
+	require.ErrorIs(t, err, errCronExists)
+}
+
+func TestFindCronJobs(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"main.go": testMain,
+		"cmd/worker/main.go": "package main\n\nfunc main() {\n\tapp := gofr.New()\n\n" +
+			"\tapp.AddCronJob(\"*/5 * * * *\", \"cleanup\", job.Cleanup)\n\tapp.AddCronJob(schedule, name, func(*gofr.Context) {})\n}\n",
+		"job/cleanup_test.go":    "package job\n\nfunc init() { app.AddCronJob(\"* * * * *\", \"test\", nil) }\n",
+		"vendor/x/x.go":          "package x\n\nfunc init() { app.AddCronJob(\"* * * * *\", \"vendored\", nil) }\n",
+		"testdata/main.go":       "package main\n\nfunc init() { app.AddCronJob(\"* * * * *\", \"golden\", nil) }\n",
+		"job/unrelated/other.go": "package unrelated\n\nfunc f() { s.AddCronJob(\"* * * * *\") }\n",
+	}
+
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), dirMode))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), fileMode))
+	}
+
+	jobs, err := findCronJobs(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []cronJob{
+		{Schedule: "*/5 * * * *", Name: "cleanup", Func: "job.Cleanup", Pos: "cmd/worker/main.go:6"},
+		{Schedule: "schedule", Name: "name", Func: "(func(*gofr.Context) literal)", Pos: "cmd/worker/main.go:7"},
+	}, jobs)
+
+	assert.Equal(t, "SCHEDULE     NAME     FUNCTION     LOCATION\n"+
+		"*/5 * * * *  cleanup  job.Cleanup  cmd/worker/main.go:6", formatCronJobs(jobs[:1]))
+	assert.Equal(t, "No cron jobs found", formatCronJobs(nil))
+}
@@ -0,0 +1,268 @@
+package add
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"gofr.dev/pkg/gofr"
+
+	"gofr.dev/cli/gofr/modcache"
+)
+
+const datasourcePackage = "gofr.dev/pkg/gofr/datasource/"
+
+var (
+	errDatasourceKind   = errors.New("please provide the kind of the datasource, e.g. gofr add datasource mongo")
+	errUnknownKind      = errors.New("unknown datasource")
+	errDatasourceExists = errors.New("main.go adds the datasource already")
+)
+
+// datasource is an external datasource, whose driver is a module of its own below gofr.dev/pkg/gofr/datasource
+// named after the kind of the datasource. The app adds it with Method(<kind>.New(<kind>.Config{...})).
+type datasource struct {
+	Method string
+	// Fields are the fields of the Config of the driver, each read from a variable of configs/.env.
+	Fields []configField
+}
+
+type configField struct {
+	Name string
+	Key  string
+	// Default is the value the key is added to configs/.env with, the project name when Named is set.
+	Default string
+	Named   bool
+	// Int reports whether the field is an int, which is parsed from the value of the key.
+	Int bool
+}
+
+//nolint:gochecknoglobals // external datasources supported by add datasource, by their kind.
+var datasources = map[string]datasource{
+	"mongo": {Method: "AddMongo", Fields: []configField{
+		{Name: "URI", Key: "MONGO_URI", Default: "mongodb://localhost:27017"},
+		{Name: "Database", Key: "MONGO_DATABASE", Named: true},
+	}},
+	"cassandra": {Method: "AddCassandra", Fields: []configField{
+		{Name: "Hosts", Key: "CASSANDRA_HOSTS", Default: "localhost"},
+		{Name: "Keyspace", Key: "CASSANDRA_KEYSPACE", Named: true},
+		{Name: "Port", Key: "CASSANDRA_PORT", Default: "9042", Int: true},
+		{Name: "Username", Key: "CASSANDRA_USERNAME", Default: "cassandra"},
+		{Name: "Password", Key: "CASSANDRA_PASSWORD", Default: "cassandra"},
+	}},
+	"scylladb": {Method: "AddScyllaDB", Fields: []configField{
+		{Name: "Host", Key: "SCYLLADB_HOST", Default: "localhost"},
+		{Name: "Keyspace", Key: "SCYLLADB_KEYSPACE", Named: true},
+		{Name: "Port", Key: "SCYLLADB_PORT", Default: "9042", Int: true},
+		{Name: "Username", Key: "SCYLLADB_USERNAME"},
+		{Name: "Password", Key: "SCYLLADB_PASSWORD"},
+	}},
+	"clickhouse": {Method: "AddClickhouse", Fields: []configField{
+		{Name: "Hosts", Key: "CLICKHOUSE_HOSTS", Default: "localhost:9000"},
+		{Name: "Username", Key: "CLICKHOUSE_USER", Default: "default"},
+		{Name: "Password", Key: "CLICKHOUSE_PASSWORD"},
+		{Name: "Database", Key: "CLICKHOUSE_DATABASE", Named: true},
+	}},
+	"arangodb": {Method: "AddArangoDB", Fields: []configField{
+		{Name: "Host", Key: "ARANGODB_HOST", Default: "localhost"},
+		{Name: "User", Key: "ARANGODB_USER", Default: "root"},
+		{Name: "Password", Key: "ARANGODB_PASSWORD"},
+		{Name: "Port", Key: "ARANGODB_PORT", Default: "8529", Int: true},
+	}},
+	"dgraph": {Method: "AddDgraph", Fields: []configField{
+		{Name: "Host", Key: "DGRAPH_HOST", Default: "localhost"},
+		{Name: "Port", Key: "DGRAPH_PORT", Default: "9080"},
+	}},
+	"solr": {Method: "AddSolr", Fields: []configField{
+		{Name: "Host", Key: "SOLR_HOST", Default: "localhost"},
+		{Name: "Port", Key: "SOLR_PORT", Default: "8983"},
+	}},
+}
+
+// datasourceTemplate is the code adding the datasource to the app, inserted into main.
+const datasourceTemplate = `
+{{- range .Fields }}{{ if .Int }}
+{{ $.Kind }}{{ .Name }}, err := strconv.Atoi({{ $.App }}.Config.Get("{{ .Key }}"))
+if err != nil {
+	{{ $.App }}.Logger().Fatalf("invalid {{ .Key }}: %v", err)
+}
+{{ end }}{{ end }}
+{{ .App }}.{{ .Method }}({{ .Kind }}.New({{ .Kind }}.Config{
+{{- range .Fields }}
+	{{ .Name }}: {{ if .Int }}{{ $.Kind }}{{ .Name }}{{ else }}{{ $.App }}.Config.Get("{{ .Key }}"){{ end }},
+{{- end }}
+}))`
+
+// Datasource adds an external datasource to the app, e.g.
+//
+//	gofr add datasource mongo
+//
+// It inserts the import of the driver and the call adding the datasource, configured from configs/.env,
+// into main.go, adds the variables of the configuration to configs/.env and requires the driver module
+// in go.mod, in the newest version found in the local module cache.
+func Datasource(ctx *gofr.Context) (any, error) {
+	kind := strings.ToLower(commandArg(os.Args[1:], "datasource"))
+
+	p, err := LoadProject(".")
+	if err != nil {
+		return nil, err
+	}
+
+	main, err := readMain(".")
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := addDatasource(".", p, main, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := datasourcePackage + kind
+	msg := fmt.Sprintf("Successfully added the %s datasource", kind)
+
+	if version := modcache.Latest(pkg); version != "" {
+		mod, modErr := requireModule(".", pkg, version)
+		if modErr != nil {
+			return nil, modErr
+		}
+
+		if mod != nil {
+			files = append(files, *mod)
+		}
+	} else {
+		msg += fmt.Sprintf(", run go get %s to require its driver", pkg)
+	}
+
+	err = writeChanges(".", nil, files, ctx.Param("force") == "true")
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// addDatasource adds the datasource of the kind to main, returning main.go and configs/.env with
+// the variables the datasource is configured with.
+func addDatasource(dir string, p *Project, main *mainFile, kind string) ([]File, error) {
+	if kind == "" {
+		return nil, errDatasourceKind
+	}
+
+	ds, ok := datasources[kind]
+	if !ok {
+		return nil, fmt.Errorf("%w %q, supported datasources are %s", errUnknownKind, kind, strings.Join(datasourceKinds(), ", "))
+	}
+
+	app, err := main.app()
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := main.calls(ds.Method, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		return nil, fmt.Errorf("%w: %s.%s", errDatasourceExists, app, ds.Method)
+	}
+
+	code, err := datasourceCode(app, kind, &ds)
+	if err != nil {
+		return nil, err
+	}
+
+	err = main.addImport("", datasourcePackage+kind)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range ds.Fields {
+		if f.Int {
+			err = main.addImport("", "strconv")
+			if err != nil {
+				return nil, err
+			}
+
+			break
+		}
+	}
+
+	err = main.insert(code)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make([]envVar, 0, len(ds.Fields))
+
+	for _, f := range ds.Fields {
+		value := f.Default
+		if f.Named {
+			value = snake(p.Name)
+		}
+
+		vars = append(vars, envVar{Key: f.Key, Value: value})
+	}
+
+	env, err := addEnv(dir, p, fmt.Sprintf("%s datasource, read in main.go when it is added to the app.", exported(kind)), vars)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []File{main.file()}
+
+	if env != nil {
+		files = append(files, *env)
+	}
+
+	return files, nil
+}
+
+func datasourceCode(app, kind string, ds *datasource) (string, error) {
+	t, err := template.New("datasource").Parse(datasourceTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+
+	err = t.Execute(&buf, map[string]any{"App": app, "Kind": kind, "Method": ds.Method, "Fields": ds.Fields})
+
+	return buf.String(), err
+}
+
+func datasourceKinds() []string {
+	kinds := make([]string, 0, len(datasources))
+
+	for kind := range datasources {
+		kinds = append(kinds, kind)
+	}
+
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+// commandArg returns the first positional argument following the command word in args, e.g. mongo
+// for "add datasource mongo", as the request params only hold the flags.
+func commandArg(args []string, command string) string {
+	for i, arg := range args {
+		if arg != command {
+			continue
+		}
+
+		for _, next := range args[i+1:] {
+			if !strings.HasPrefix(next, "-") {
+				return next
+			}
+		}
+
+		break
+	}
+
+	return ""
+}
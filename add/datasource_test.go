@@ -0,0 +1,81 @@
+package add
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddDatasource(t *testing.T) {
+	dir := t.TempDir()
+
+	env := "APP_NAME=shop\nCASSANDRA_HOSTS=cassandra"
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "configs"), dirMode))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, envFile), []byte(env), fileMode))
+
+	p := testProject(t, env)
+	main := &mainFile{src: []byte(testMain)}
+
+	files, err := addDatasource(dir, p, main, "cassandra")
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	assertGolden(t, filepath.Join("testdata", "datasource", "main.go"), files[0].Content)
+	assertGolden(t, filepath.Join("testdata", "datasource", ".env"), files[1].Content)
+
+	_, err = addDatasource(dir, p, main, "cassandra")
+
+	require.ErrorIs(t, err, errDatasourceExists)
+}
+
+func TestAddDatasource_Errors(t *testing.T) {
+	tests := []struct {
+		desc string
+		kind string
+		err  error
+	}{
+		{"kind missing", "", errDatasourceKind},
+		{"unknown kind", "oracle", errUnknownKind},
+	}
+
+	for i, tc := range tests {
+		_, err := addDatasource(t.TempDir(), testProject(t, ""), &mainFile{src: []byte(testMain)}, tc.kind)
+
+		assert.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestRequireModule(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module github.com/acme/shop\n\ngo 1.22\n\n"+
+		"require gofr.dev v1.28.0\n"), fileMode))
+
+	mod, err := requireModule(dir, "gofr.dev/pkg/gofr/datasource/mongo", "v0.1.0")
+	require.NoError(t, err)
+	assert.Contains(t, string(mod.Content), "gofr.dev/pkg/gofr/datasource/mongo v0.1.0")
+
+	mod, err = requireModule(dir, "gofr.dev", "v1.30.0")
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestCommandArg(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"add", "datasource", "mongo"}, "mongo"},
+		{[]string{"add", "datasource", "-force", "mongo"}, "mongo"},
+		{[]string{"add", "datasource"}, ""},
+		{[]string{"add", "docker"}, ""},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.want, commandArg(tc.args, "datasource"), "TEST[%d], Failed.\n%v", i, tc.args)
+	}
+}
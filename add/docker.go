@@ -0,0 +1,295 @@
+package add
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"gofr.dev/pkg/gofr"
+)
+
+const (
+	defaultHTTPPort    = "8000"
+	defaultMetricsPort = "2121"
+
+	dockerfileTemplate = `# syntax=docker/dockerfile:1
+
+# Generated by gofr add docker.
+
+FROM golang:{{ .GoVersion }}-alpine AS build
+
+WORKDIR /src
+
+COPY go.mod go.sum* ./
+RUN go mod download
+
+COPY . .
+RUN CGO_ENABLED=0 GOOS=linux go build -trimpath -ldflags="-s -w" -o /out/{{ .Name }} .
+
+FROM gcr.io/distroless/static-debian12:nonroot
+
+WORKDIR /app
+
+COPY --from=build /out/{{ .Name }} ./{{ .Name }}
+COPY --from=build /src/configs ./configs
+{{- if .Static }}
+COPY --from=build /src/static ./static
+{{- end }}
+{{- range .Ports }}
+
+# {{ .Description }}
+EXPOSE {{ .Port }}
+{{- end }}
+
+ENTRYPOINT ["/app/{{ .Name }}"]
+`
+
+	dockerignoreTemplate = `.git
+.idea
+.vscode
+Dockerfile
+docker-compose.yml
+*.db
+`
+
+	composeTemplate = `# Generated by gofr add docker.
+
+services:
+  {{ .Name }}:
+    build: .
+{{- if .Ports }}
+    ports:
+{{- range .Ports }}
+      - "{{ .Port }}:{{ .Port }}"
+{{- end }}
+{{- end }}
+    env_file:
+      - configs/.env
+{{- if .AppEnv }}
+    environment:
+{{- range .AppEnv }}
+      {{ .Key }}: {{ quote .Value }}
+{{- end }}
+{{- end }}
+{{- if .Services }}
+    depends_on:
+{{- range .Services }}
+      - {{ .Name }}
+{{- end }}
+{{- end }}
+{{- range .Services }}
+
+  {{ .Name }}:
+    image: {{ .Image }}
+{{- if .Command }}
+    command: {{ .Command }}
+{{- end }}
+{{- if .Environment }}
+    environment:
+{{- range .Environment }}
+      {{ .Key }}: {{ quote .Value }}
+{{- end }}
+{{- end }}
+{{- if .Ports }}
+    ports:
+{{- range .Ports }}
+      - "{{ . }}:{{ . }}"
+{{- end }}
+{{- end }}
+{{- end }}
+`
+)
+
+type port struct {
+	Port        string
+	Description string
+}
+
+type envVar struct {
+	Key   string
+	Value string
+}
+
+// composeService is a datasource started next to the application by docker compose.
+type composeService struct {
+	Name        string
+	Image       string
+	Command     string
+	Environment []envVar
+	Ports       []string
+
+	// appEnv overrides the variables of configs/.env so that the application reaches the service.
+	appEnv []envVar
+}
+
+type dockerData struct {
+	Name      string
+	GoVersion string
+	Static    bool
+	Ports     []port
+	AppEnv    []envVar
+	Services  []composeService
+}
+
+// Docker generates a Dockerfile and a docker-compose.yml for the project in the current directory.
+// The Dockerfile exposes the ports the application is configured for, the compose file starts the
+// datasources configured in configs/.env next to it. Existing files are only overwritten with -force.
+func Docker(ctx *gofr.Context) (any, error) {
+	p, err := LoadProject(".")
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := DockerFiles(p)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeFiles(".", files, ctx.Param("force") == "true")
+	if err != nil {
+		return nil, err
+	}
+
+	return "Successfully generated Dockerfile and docker-compose.yml for " + p.Name, nil
+}
+
+// DockerFiles renders the Dockerfile, .dockerignore and docker-compose.yml of the project. The output
+// only depends on the project, so generating the files twice yields the same content.
+func DockerFiles(p *Project) ([]File, error) {
+	data := dockerData{
+		Name:      p.Name,
+		GoVersion: goImageVersion(p.GoVersion),
+		Static:    p.Static,
+		Services:  composeServices(p),
+	}
+
+	if !p.CMD {
+		data.Ports = append(data.Ports, port{p.Env.GetOrDefault("HTTP_PORT", defaultHTTPPort), "HTTP server"})
+
+		if grpcPort := p.Env.Get("GRPC_PORT"); grpcPort != "" {
+			data.Ports = append(data.Ports, port{grpcPort, "gRPC server"})
+		}
+
+		data.Ports = append(data.Ports, port{p.Env.GetOrDefault("METRICS_PORT", defaultMetricsPort), "metrics"})
+	}
+
+	for _, s := range data.Services {
+		data.AppEnv = append(data.AppEnv, s.appEnv...)
+	}
+
+	sort.Slice(data.AppEnv, func(i, j int) bool { return data.AppEnv[i].Key < data.AppEnv[j].Key })
+
+	return renderFiles(data, "{{", "}}", []File{
+		{Path: "Dockerfile", Content: []byte(dockerfileTemplate)},
+		{Path: ".dockerignore", Content: []byte(dockerignoreTemplate)},
+		{Path: "docker-compose.yml", Content: []byte(composeTemplate)},
+	})
+}
+
+// composeServices returns the services for the datasources configured in configs/.env, sorted by name.
+func composeServices(p *Project) []composeService {
+	var services []composeService
+
+	switch p.Env.Get("DB_DIALECT") {
+	case "mysql":
+		services = append(services, mysqlService(p))
+	case "postgres":
+		services = append(services, composeService{
+			Name:  "postgres",
+			Image: "postgres:16-alpine",
+			Environment: []envVar{
+				{"POSTGRES_USER", p.Env.GetOrDefault("DB_USER", "postgres")},
+				{"POSTGRES_PASSWORD", p.Env.Get("DB_PASSWORD")},
+				{"POSTGRES_DB", p.Env.Get("DB_NAME")},
+			},
+			Ports:  []string{"5432"},
+			appEnv: []envVar{{"DB_HOST", "postgres"}, {"DB_PORT", "5432"}},
+		})
+	}
+
+	if _, ok := p.Env.Lookup("REDIS_HOST"); ok {
+		services = append(services, composeService{
+			Name:   "redis",
+			Image:  "redis:7-alpine",
+			Ports:  []string{"6379"},
+			appEnv: []envVar{{"REDIS_HOST", "redis"}, {"REDIS_PORT", "6379"}},
+		})
+	}
+
+	if _, ok := p.Env.Lookup("MONGO_URI"); ok {
+		services = append(services, composeService{
+			Name:   "mongo",
+			Image:  "mongo:7",
+			Ports:  []string{"27017"},
+			appEnv: []envVar{{"MONGO_URI", "mongodb://mongo:27017"}},
+		})
+	}
+
+	switch strings.ToUpper(p.Env.Get("PUBSUB_BACKEND")) {
+	case "KAFKA":
+		services = append(services, kafkaService())
+	case "MQTT":
+		services = append(services, composeService{
+			Name:    "mqtt",
+			Image:   "eclipse-mosquitto:2",
+			Command: "mosquitto -c /mosquitto-no-auth.conf",
+			Ports:   []string{"1883"},
+			appEnv:  []envVar{{"MQTT_HOST", "mqtt"}, {"MQTT_PORT", "1883"}},
+		})
+	}
+
+	sort.Slice(services, func(i, j int) bool { return services[i].Name < services[j].Name })
+
+	return services
+}
+
+func mysqlService(p *Project) composeService {
+	s := composeService{
+		Name:  "mysql",
+		Image: "mysql:8.0",
+		Environment: []envVar{
+			{"MYSQL_ROOT_PASSWORD", p.Env.Get("DB_PASSWORD")},
+			{"MYSQL_DATABASE", p.Env.Get("DB_NAME")},
+		},
+		Ports:  []string{"3306"},
+		appEnv: []envVar{{"DB_HOST", "mysql"}, {"DB_PORT", "3306"}},
+	}
+
+	if user := p.Env.GetOrDefault("DB_USER", "root"); user != "root" {
+		s.Environment = append(s.Environment, envVar{"MYSQL_USER", user}, envVar{"MYSQL_PASSWORD", p.Env.Get("DB_PASSWORD")})
+	}
+
+	return s
+}
+
+// kafkaService runs a single node kafka in KRaft mode, it is only reachable from the compose network.
+func kafkaService() composeService {
+	return composeService{
+		Name:  "kafka",
+		Image: "bitnami/kafka:3.7",
+		Environment: []envVar{
+			{"KAFKA_CFG_NODE_ID", "0"},
+			{"KAFKA_CFG_PROCESS_ROLES", "controller,broker"},
+			{"KAFKA_CFG_LISTENERS", "PLAINTEXT://:9092,CONTROLLER://:9093"},
+			{"KAFKA_CFG_ADVERTISED_LISTENERS", "PLAINTEXT://kafka:9092"},
+			{"KAFKA_CFG_CONTROLLER_QUORUM_VOTERS", "0@kafka:9093"},
+			{"KAFKA_CFG_CONTROLLER_LISTENER_NAMES", "CONTROLLER"},
+			{"KAFKA_CFG_LISTENER_SECURITY_PROTOCOL_MAP", "CONTROLLER:PLAINTEXT,PLAINTEXT:PLAINTEXT"},
+		},
+		appEnv: []envVar{{"PUBSUB_BROKER", "kafka:9092"}},
+	}
+}
+
+// goImageVersion returns the tag of the golang image for the go directive, images are only published
+// for releases so pre-release versions fall back to their minor version.
+func goImageVersion(goVersion string) string {
+	if goVersion == "" {
+		return "1"
+	}
+
+	if i := strings.IndexFunc(goVersion, unicode.IsLetter); i >= 0 {
+		return goVersion[:i]
+	}
+
+	return goVersion
+}
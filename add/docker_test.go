@@ -0,0 +1,42 @@
+package add
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/cli/gofr/envfile"
+)
+
+func TestDockerFiles(t *testing.T) {
+	tests := []struct {
+		desc string
+		p    Project
+		env  string
+	}{
+		{"rest-mysql-redis-kafka", Project{Name: "orders", GoVersion: "1.22.4"},
+			"APP_NAME=orders\nHTTP_PORT=8080\nDB_DIALECT=mysql\nDB_USER=orders\nDB_PASSWORD=secret\nDB_NAME=orders\n" +
+				"REDIS_HOST=localhost\nPUBSUB_BACKEND=KAFKA\nPUBSUB_BROKER=localhost:9092\n"},
+		{"grpc-postgres", Project{Name: "payments", GoVersion: "1.23rc1", Static: true},
+			"APP_NAME=payments\nGRPC_PORT=9000\nDB_DIALECT=postgres\nDB_USER=postgres\nDB_PASSWORD=password\nDB_NAME=payments\n"},
+		{"cmd", Project{Name: "importer", GoVersion: "1.22", CMD: true}, "APP_NAME=importer\n"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			env, err := envfile.Parse(strings.NewReader(tc.env))
+			require.NoError(t, err)
+
+			tc.p.Env = env
+
+			files, err := DockerFiles(&tc.p)
+			require.NoError(t, err)
+
+			for _, f := range files {
+				assertGolden(t, filepath.Join("testdata", "docker", tc.desc, f.Path), f.Content)
+			}
+		})
+	}
+}
@@ -0,0 +1,569 @@
+package add
+
+import (
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"gofr.dev/pkg/gofr"
+
+	"gofr.dev/cli/gofr/migration"
+)
+
+const (
+	migrationsDir = "migrations"
+	// wrapWidth is the length of the queries and argument lists of the store after which they continue on the next line.
+	wrapWidth = 80
+)
+
+var (
+	errEntityName     = errors.New(`please provide the name of the entity, a Go identifier, using the "-name" option`)
+	errFields         = errors.New(`please provide the fields of the entity using the "-fields" option, e.g. -fields="id:int,name:string"`)
+	errField          = errors.New("invalid field, expected name:type with type one of int, int64, string, float, bool or time")
+	errIDType         = errors.New("the id field has to be an int, int64 or string")
+	errNoSQL          = errors.New("no SQL datasource configured, set DB_DIALECT to mysql, postgres or sqlite in configs/.env")
+	errDuplicateField = errors.New("duplicate field")
+)
+
+//nolint:gochecknoglobals // Go types of the field types accepted by -fields.
+var fieldTypes = map[string]string{
+	"int":    "int",
+	"int64":  "int64",
+	"string": "string",
+	"float":  "float64",
+	"bool":   "bool",
+	"time":   "time.Time",
+}
+
+//nolint:gochecknoglobals // SQL column types of the Go field types, per dialect.
+var columnTypes = map[string]map[string]string{
+	"mysql": {"int": "INT", "int64": "BIGINT", "string": "VARCHAR(255)", "float64": "DOUBLE", "bool": "BOOLEAN",
+		"time.Time": "DATETIME"},
+	"postgres": {"int": "INTEGER", "int64": "BIGINT", "string": "VARCHAR(255)", "float64": "DOUBLE PRECISION",
+		"bool": "BOOLEAN", "time.Time": "TIMESTAMP"},
+	"sqlite": {"int": "INTEGER", "int64": "INTEGER", "string": "TEXT", "float64": "REAL", "bool": "BOOLEAN",
+		"time.Time": "DATETIME"},
+}
+
+// field is a field of an entity, given as name:type with -fields.
+type field struct {
+	// Name is the name of the struct field.
+	Name string
+	// Type is the Go type of the field.
+	Type string
+	// JSON is the field name as given, used for the JSON key.
+	JSON string
+	// Column is the name of the table column.
+	Column string
+}
+
+type queries struct {
+	Insert     string
+	SelectAll  string
+	SelectByID string
+	Update     string
+	Delete     string
+}
+
+type entityData struct {
+	Module  string
+	Name    string
+	Var     string
+	Plural  string
+	Path    string
+	Table   string
+	Dialect string
+	// Fields holds all the fields, the id first.
+	Fields []field
+	// Columns holds the fields without the id.
+	Columns []field
+	ID      field
+	// AutoID reports whether the database generates the ids.
+	AutoID  bool
+	Queries queries
+}
+
+// Entity scaffolds CRUD for an entity across all the layers of the project: the model, an SQL store
+// using ctx.SQL, the service and the handler. It registers the routes in main.go and creates a
+// migration for the table of the entity, e.g.
+//
+//	gofr add entity -name=Order -fields="id:int,customer:string,total:float"
+//
+// An integer id is generated by the database. Existing files are only overwritten with -force.
+func Entity(ctx *gofr.Context) (any, error) {
+	p, err := LoadProject(".")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := newEntityData(p, ctx.Param("name"), ctx.Param("fields"))
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := entityFiles(data)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := entityMigration(data, migrationsDir, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	main, err := readMain(".")
+	if err != nil {
+		return nil, err
+	}
+
+	err = registerEntity(main, data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeChanges(".", files, append(migrations, main.file()), ctx.Param("force") == "true")
+	if err != nil {
+		return nil, err
+	}
+
+	return fmt.Sprintf("Successfully added entity %s, run go mod tidy to sync the dependencies", data.Name), nil
+}
+
+func newEntityData(p *Project, name, fields string) (*entityData, error) {
+	if !token.IsIdentifier(name) {
+		return nil, errEntityName
+	}
+
+	dialect := p.Env.Get("DB_DIALECT")
+	if _, ok := columnTypes[dialect]; !ok {
+		return nil, errNoSQL
+	}
+
+	name = exported(name)
+
+	d := &entityData{
+		Module:  p.Module,
+		Name:    name,
+		Var:     entityVar(name),
+		Plural:  entityVar(plural(name)),
+		Path:    "/" + strings.ReplaceAll(snake(name), "_", "-"),
+		Table:   plural(snake(name)),
+		Dialect: dialect,
+	}
+
+	err := d.parseFields(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Queries = d.queries()
+
+	return d, nil
+}
+
+// parseFields parses the comma separated name:type list, an int id is added when the list has no id.
+func (d *entityData) parseFields(fields string) error {
+	if strings.TrimSpace(fields) == "" {
+		return errFields
+	}
+
+	seen := make(map[string]bool)
+	hasID := false
+
+	for _, def := range strings.Split(fields, ",") {
+		name, typ, ok := strings.Cut(strings.TrimSpace(def), ":")
+		goType, known := fieldTypes[strings.TrimSpace(typ)]
+
+		name = strings.TrimSpace(name)
+		if !ok || !known || !token.IsIdentifier(name) {
+			return fmt.Errorf("%w: %q", errField, def)
+		}
+
+		f := field{Name: exported(name), Type: goType, JSON: name, Column: snake(name)}
+
+		if seen[f.Column] {
+			return fmt.Errorf("%w: %q", errDuplicateField, name)
+		}
+
+		seen[f.Column] = true
+
+		if f.Column == "id" {
+			if goType != "int" && goType != "int64" && goType != "string" {
+				return errIDType
+			}
+
+			d.ID, hasID = f, true
+
+			continue
+		}
+
+		d.Columns = append(d.Columns, f)
+	}
+
+	if len(d.Columns) == 0 {
+		return errFields
+	}
+
+	if !hasID {
+		d.ID = field{Name: "ID", Type: "int", JSON: "id", Column: "id"}
+	}
+
+	d.AutoID = d.ID.Type != "string"
+	d.Fields = append([]field{d.ID}, d.Columns...)
+
+	return nil
+}
+
+func (d *entityData) queries() queries {
+	columns := func(fields []field) string {
+		names := make([]string, 0, len(fields))
+		for _, f := range fields {
+			names = append(names, f.Column)
+		}
+
+		return strings.Join(names, ", ")
+	}
+
+	inserted := d.Fields
+	if d.AutoID {
+		inserted = d.Columns
+	}
+
+	values := make([]string, 0, len(inserted))
+	for i := range inserted {
+		values = append(values, d.placeholder(i+1))
+	}
+
+	sets := make([]string, 0, len(d.Columns))
+	for i, f := range d.Columns {
+		sets = append(sets, f.Column+" = "+d.placeholder(i+1))
+	}
+
+	q := queries{
+		Insert:     fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", d.Table, columns(inserted), strings.Join(values, ", ")),
+		SelectAll:  fmt.Sprintf("SELECT %s FROM %s", columns(d.Fields), d.Table),
+		SelectByID: fmt.Sprintf("SELECT %s FROM %s WHERE id = %s", columns(d.Fields), d.Table, d.placeholder(1)),
+		Update: fmt.Sprintf("UPDATE %s SET %s WHERE id = %s", d.Table, strings.Join(sets, ", "),
+			d.placeholder(len(d.Columns)+1)),
+		Delete: fmt.Sprintf("DELETE FROM %s WHERE id = %s", d.Table, d.placeholder(1)),
+	}
+
+	if d.AutoID && d.Dialect == "postgres" {
+		q.Insert += " RETURNING id"
+	}
+
+	return q
+}
+
+func (d *entityData) placeholder(i int) string {
+	if d.Dialect == "postgres" {
+		return fmt.Sprintf("$%d", i)
+	}
+
+	return "?"
+}
+
+// Args returns the fields of the entity as arguments of a query, followed by the extra ones.
+func (d *entityData) Args(fields []field, extra ...field) string {
+	args := make([]string, 0, len(fields)+len(extra))
+	for _, f := range append(append([]field{}, fields...), extra...) {
+		args = append(args, d.Var+"."+f.Name)
+	}
+
+	return wrapList(args)
+}
+
+// ScanArgs returns the arguments of rows.Scan, scanning the columns of a select into the entity.
+func (d *entityData) ScanArgs() string {
+	args := make([]string, 0, len(d.Fields))
+	for _, f := range d.Fields {
+		args = append(args, "&"+d.Var+"."+f.Name)
+	}
+
+	return wrapList(args)
+}
+
+// Query returns the query as a Go string constant, split into concatenated strings on lines of at most
+// wrapWidth characters so that the generated code passes the line length limit of GoFr's lint config.
+func (*entityData) Query(query string) string {
+	words := strings.Split(query, " ")
+	clauses := []string{words[0]}
+
+	for _, w := range words[1:] {
+		switch w {
+		case "FROM", "VALUES", "WHERE", "RETURNING":
+			clauses = append(clauses, w)
+		default:
+			clauses[len(clauses)-1] += " " + w
+		}
+	}
+
+	var lines []string
+
+	// the clauses are kept on one line, unless they are longer than wrapWidth themselves and are split after commas.
+	for _, line := range wrapWords(clauses, " ") {
+		lines = append(lines, wrapWords(strings.SplitAfter(line, ", "), "")...)
+	}
+
+	parts := make([]string, 0, len(lines))
+
+	for i, line := range lines {
+		if i < len(lines)-1 && !strings.HasSuffix(line, " ") {
+			line += " "
+		}
+
+		parts = append(parts, strconv.Quote(line))
+	}
+
+	return strings.Join(parts, " +\n\t\t")
+}
+
+// wrapList joins the arguments of a call, continuing them on the next line when they get longer than wrapWidth.
+func wrapList(args []string) string {
+	return strings.Join(wrapWords(args, ", "), ",\n\t\t")
+}
+
+// wrapWords joins the words with sep into lines of at most wrapWidth characters, a longer word gets a line of its own.
+func wrapWords(words []string, sep string) []string {
+	var lines []string
+
+	line := ""
+
+	for _, w := range words {
+		switch {
+		case line == "":
+			line = w
+		case len(line)+len(sep)+len(w) > wrapWidth:
+			lines = append(lines, line)
+			line = w
+		default:
+			line += sep + w
+		}
+	}
+
+	return append(lines, line)
+}
+
+// HasTime reports whether the model imports the time package.
+func (d *entityData) HasTime() bool {
+	for _, f := range d.Fields {
+		if f.Type == "time.Time" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IDParser is the name of the function the handler reads the id from the path with.
+func (d *entityData) IDParser() string {
+	return "parse" + d.Name + "ID"
+}
+
+// entityFiles renders the model, store, service and handler of the entity.
+func entityFiles(d *entityData) ([]File, error) {
+	file := snake(d.Name) + ".go"
+
+	var files []File
+
+	for _, layer := range []struct{ template, dir string }{
+		{"model", "models"}, {"store", "store"}, {"service", "service"}, {"handler", "handler"},
+	} {
+		content, err := templates.ReadFile(path.Join("templates", "entity", layer.template+".go.tmpl"))
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, File{Path: path.Join(layer.dir, file), Content: content})
+	}
+
+	files, err := renderFiles(d, "{{", "}}", files)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatGo(files)
+}
+
+// entityMigration creates the migration for the table of the entity in the migrations directory dir,
+// in the same way as gofr migrate create does, and the regenerated all.go registering it.
+func entityMigration(d *entityData, dir string, createdAt time.Time) ([]File, error) {
+	columns := make([]string, 0, len(d.Fields))
+
+	for _, f := range d.Fields {
+		column := f.Column + " " + columnTypes[d.Dialect][f.Type]
+
+		switch {
+		case f.Column != "id":
+			column += " NOT NULL"
+		case !d.AutoID:
+			column += " PRIMARY KEY"
+		case d.Dialect == "postgres" && f.Type == "int64":
+			column = "id BIGSERIAL PRIMARY KEY"
+		case d.Dialect == "postgres":
+			column = "id SERIAL PRIMARY KEY"
+		case d.Dialect == "sqlite":
+			column = "id INTEGER PRIMARY KEY AUTOINCREMENT"
+		default:
+			column += " AUTO_INCREMENT PRIMARY KEY"
+		}
+
+		columns = append(columns, "\t"+column)
+	}
+
+	up := fmt.Sprintf("_, err := d.SQL.Exec(`CREATE TABLE IF NOT EXISTS %s (\n%s\n)`)\n\nreturn err",
+		d.Table, strings.Join(columns, ",\n"))
+
+	name := "create" + exported(plural(d.Name)) + "Table"
+
+	fileName, content, all, err := migration.Generate(dir, migration.Migration{Name: name, Up: up}, createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatGo([]File{
+		{Path: path.Join(migrationsDir, fileName), Content: content},
+		{Path: path.Join(migrationsDir, "all.go"), Content: all},
+	})
+}
+
+// registerEntity creates the layers of the entity in main and registers its routes. Projects without
+// migrations get them wired as well.
+func registerEntity(main *mainFile, d *entityData) error {
+	app, err := main.app()
+	if err != nil {
+		return err
+	}
+
+	if !main.contains(".Migrate(migrations.All())") {
+		if err = main.addImport("", d.Module+"/"+migrationsDir); err != nil {
+			return err
+		}
+
+		if err = main.insert(app + ".Migrate(migrations.All())"); err != nil {
+			return err
+		}
+	}
+
+	for _, layer := range []string{"handler", "service", "store"} {
+		if err = main.addImport("", d.Module+"/"+layer); err != nil {
+			return err
+		}
+	}
+
+	h := d.Var + "Handler"
+
+	return main.insert(fmt.Sprintf(`%[1]s := handler.New%[2]s(service.New%[2]s(store.New%[2]s()))
+
+%[3]s.POST("%[4]s", %[1]s.Create)
+%[3]s.GET("%[4]s", %[1]s.GetAll)
+%[3]s.GET("%[4]s/{id}", %[1]s.GetByID)
+%[3]s.PUT("%[4]s/{id}", %[1]s.Update)
+%[3]s.DELETE("%[4]s/{id}", %[1]s.Delete)`, h, d.Name, app, d.Path))
+}
+
+func formatGo(files []File) ([]File, error) {
+	for i, f := range files {
+		content, err := format.Source(f.Content)
+		if err != nil {
+			return nil, fmt.Errorf("formatting %s: %w", f.Path, err)
+		}
+
+		files[i].Content = content
+	}
+
+	return files, nil
+}
+
+// exported returns name with its first letter in upper case, using the ID initialism for id. Names which
+// are exported already are kept as they are.
+func exported(name string) string {
+	if r := []rune(name); len(r) > 0 && unicode.IsUpper(r[0]) && !strings.Contains(name, "_") {
+		return name
+	}
+
+	parts := strings.Split(snake(name), "_")
+
+	for i, p := range parts {
+		if p == "id" {
+			parts[i] = "ID"
+			continue
+		}
+
+		r := []rune(p)
+		if len(r) > 0 {
+			r[0] = unicode.ToUpper(r[0])
+		}
+
+		parts[i] = string(r)
+	}
+
+	return strings.Join(parts, "")
+}
+
+// snake converts an identifier like CustomerID or customerId into customer_id.
+func snake(name string) string {
+	var b strings.Builder
+
+	runes := []rune(name)
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && runes[i-1] != '_' &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteByte('_')
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+// plural returns the English plural of a singular noun, good enough for table names.
+func plural(s string) string {
+	lower := strings.ToLower(s)
+
+	switch {
+	case strings.HasSuffix(lower, "y") && len(s) > 1 && !strings.ContainsRune("aeiou", rune(lower[len(lower)-2])):
+		return s[:len(s)-1] + "ies"
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"), strings.HasSuffix(lower, "ch"),
+		strings.HasSuffix(lower, "sh"):
+		return s + "es"
+	default:
+		return s + "s"
+	}
+}
+
+// entityVar returns the variable name for an exported identifier, avoiding the keywords and the
+// packages the generated files import.
+func entityVar(name string) string {
+	r := []rune(name)
+
+	for i := 0; i < len(r) && unicode.IsUpper(r[i]); i++ {
+		if i > 0 && i+1 < len(r) && unicode.IsLower(r[i+1]) {
+			break
+		}
+
+		r[i] = unicode.ToLower(r[i])
+	}
+
+	v := string(r)
+
+	switch v {
+	case "ctx", "err", "id", "res", "rows", "sql", "errors", "fmt", "gofr", "http", "models", "strconv", "service",
+		"store", "handler":
+		return v + "Entity"
+	}
+
+	if token.IsKeyword(v) {
+		return v + "Entity"
+	}
+
+	return v
+}
@@ -0,0 +1,151 @@
+package add
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/cli/gofr/envfile"
+)
+
+const testMain = `package main
+
+import (
+	"gofr.dev/pkg/gofr"
+)
+
+// main starts the shop.
+func main() {
+	app := gofr.New()
+
+	// health of the shop
+	app.GET("/ping", func(*gofr.Context) (any, error) { return "pong", nil })
+
+	app.Run() // blocks
+}
+`
+
+func testProject(t *testing.T, env string) *Project {
+	t.Helper()
+
+	e, err := envfile.Parse(strings.NewReader(env))
+	require.NoError(t, err)
+
+	return &Project{Module: "github.com/acme/shop", Name: "shop", Env: e}
+}
+
+func TestEntityFiles(t *testing.T) {
+	tests := []struct {
+		desc    string
+		dialect string
+		name    string
+		fields  string
+	}{
+		{"postgres", "postgres", "Order", "id:int,customer:string,total:float,created_at:time"},
+		{"mysql", "mysql", "lineItem", "sku:string,quantity:int64,gift:bool"},
+		{"sqlite-string-id", "sqlite", "Category", "id:string,name:string"},
+	}
+
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			d, err := newEntityData(testProject(t, "DB_DIALECT="+tc.dialect), tc.name, tc.fields)
+			require.NoError(t, err)
+
+			files, err := entityFiles(d)
+			require.NoError(t, err)
+
+			migrations, err := entityMigration(d, t.TempDir(), createdAt)
+			require.NoError(t, err)
+
+			for _, f := range append(files, migrations...) {
+				assertGolden(t, filepath.Join("testdata", "entity", tc.desc, f.Path), f.Content)
+			}
+		})
+	}
+}
+
+// TestEntityFiles_LineLength checks that the store of an entity with many fields stays within the line length
+// limit of GoFr's lint config.
+func TestEntityFiles_LineLength(t *testing.T) {
+	fields := "customer_name:string,customer_email:string,shipping_address:string,billing_address:string," +
+		"total_amount:float,discount_amount:float,created_at:time,updated_at:time"
+
+	for _, dialect := range []string{"postgres", "mysql", "sqlite"} {
+		d, err := newEntityData(testProject(t, "DB_DIALECT="+dialect), "PurchaseOrder", fields)
+		require.NoError(t, err)
+
+		files, err := entityFiles(d)
+		require.NoError(t, err)
+
+		for _, f := range files {
+			for i, line := range strings.Split(string(f.Content), "\n") {
+				assert.LessOrEqual(t, len(line), 140, "%s: %s:%d", dialect, f.Path, i+1)
+			}
+		}
+	}
+}
+
+func TestNewEntityData_Errors(t *testing.T) {
+	tests := []struct {
+		desc   string
+		env    string
+		name   string
+		fields string
+		err    error
+	}{
+		{"no SQL datasource", "REDIS_HOST=localhost", "Order", "total:float", errNoSQL},
+		{"name missing", "DB_DIALECT=mysql", "", "total:float", errEntityName},
+		{"fields missing", "DB_DIALECT=mysql", "Order", "", errFields},
+		{"only an id", "DB_DIALECT=mysql", "Order", "id:int", errFields},
+		{"unknown type", "DB_DIALECT=mysql", "Order", "total:decimal", errField},
+		{"float id", "DB_DIALECT=mysql", "Order", "id:float,total:float", errIDType},
+		{"duplicate field", "DB_DIALECT=mysql", "Order", "customerID:int,customer_id:int", errDuplicateField},
+	}
+
+	for i, tc := range tests {
+		_, err := newEntityData(testProject(t, tc.env), tc.name, tc.fields)
+
+		require.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestRegisterEntity(t *testing.T) {
+	d, err := newEntityData(testProject(t, "DB_DIALECT=mysql"), "Order", "total:float")
+	require.NoError(t, err)
+
+	main := &mainFile{src: []byte(testMain)}
+
+	require.NoError(t, registerEntity(main, d))
+
+	assertGolden(t, filepath.Join("testdata", "entity", "main.go"), main.src)
+
+	require.NoError(t, registerEntity(main, d))
+
+	assertGolden(t, filepath.Join("testdata", "entity", "main.go"), main.src)
+}
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		name, exported, snake, plural, variable string
+	}{
+		{"Order", "Order", "order", "Orders", "order"},
+		{"customerId", "CustomerID", "customer_id", "customerIds", "customerID"},
+		{"URLMap", "URLMap", "url_map", "URLMaps", "urlMap"},
+		{"Category", "Category", "category", "Categories", "category"},
+		{"Box", "Box", "box", "Boxes", "box"},
+		{"Type", "Type", "type", "Types", "typeEntity"},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.exported, exported(tc.name), "TEST[%d], Failed.\n%s", i, tc.name)
+		assert.Equal(t, tc.snake, snake(tc.name), "TEST[%d], Failed.\n%s", i, tc.name)
+		assert.Equal(t, tc.plural, plural(tc.name), "TEST[%d], Failed.\n%s", i, tc.name)
+		assert.Equal(t, tc.variable, entityVar(exported(tc.name)), "TEST[%d], Failed.\n%s", i, tc.name)
+	}
+}
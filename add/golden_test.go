@@ -0,0 +1,29 @@
+package add
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:gochecknoglobals // test flag to regenerate the golden files.
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// assertGolden compares got with the golden file, which is written first when the tests run with -update.
+func assertGolden(t *testing.T, golden string, got []byte) {
+	t.Helper()
+
+	if *update {
+		require.NoError(t, os.MkdirAll(filepath.Dir(golden), dirMode))
+		require.NoError(t, os.WriteFile(golden, got, fileMode))
+	}
+
+	want, err := os.ReadFile(golden)
+	require.NoError(t, err)
+
+	assert.Equal(t, string(want), string(got), golden)
+}
@@ -0,0 +1,165 @@
+package add
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"path"
+	"regexp"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+)
+
+const handlerDir = "handler"
+
+var (
+	errHandlerName   = errors.New(`please provide the name of the handler, an exported Go identifier, using the "-name" option`)
+	errHandlerPath   = errors.New(`please provide the path of the route, starting with a "/", using the "-path" option`)
+	errHandlerMethod = errors.New("invalid method, supported methods are GET, POST, PUT, PATCH and DELETE")
+	errRouteExists   = errors.New("main.go registers the route already")
+)
+
+var pathParam = regexp.MustCompile(`{([^{}/]+)}`)
+
+type handlerData struct {
+	Name   string
+	Method string
+	Path   string
+	// Params holds the names of the path parameters.
+	Params []string
+}
+
+// Body reports whether the request of the handler has a body to bind.
+func (h *handlerData) Body() bool {
+	return h.Method == "POST" || h.Method == "PUT" || h.Method == "PATCH"
+}
+
+// MethodName is the name of the constant of the method in net/http without its Method prefix.
+func (h *handlerData) MethodName() string {
+	return h.Method[:1] + strings.ToLower(h.Method[1:])
+}
+
+// RequestPath returns the path with the value 1 for every path parameter, used in the test.
+func (h *handlerData) RequestPath() string {
+	return pathParam.ReplaceAllString(h.Path, "1")
+}
+
+// Handler generates a handler function in the handler package and a test for it, and registers the route
+// in the main function of main.go, e.g.
+//
+//	gofr add handler -method=POST -path=/orders/{id} -name=UpdateOrder
+//
+// The method defaults to GET. Existing files are only overwritten with -force.
+func Handler(ctx *gofr.Context) (any, error) {
+	p, err := LoadProject(".")
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := newHandlerData(ctx.Param("name"), ctx.Param("method"), ctx.Param("path"))
+	if err != nil {
+		return nil, err
+	}
+
+	main, err := readMain(".")
+	if err != nil {
+		return nil, err
+	}
+
+	err = registerHandler(main, p.Module, h)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := handlerFiles(h)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeChanges(".", files, []File{main.file()}, ctx.Param("force") == "true")
+	if err != nil {
+		return nil, err
+	}
+
+	return fmt.Sprintf("Successfully added handler %s for %s %s", h.Name, h.Method, h.Path), nil
+}
+
+func newHandlerData(name, method, routePath string) (*handlerData, error) {
+	if !token.IsIdentifier(name) || !token.IsExported(name) {
+		return nil, errHandlerName
+	}
+
+	if !strings.HasPrefix(routePath, "/") {
+		return nil, errHandlerPath
+	}
+
+	method = strings.ToUpper(method)
+
+	switch method {
+	case "":
+		method = "GET"
+	case "GET", "POST", "PUT", "PATCH", "DELETE":
+	default:
+		return nil, fmt.Errorf("%w: %q", errHandlerMethod, method)
+	}
+
+	h := &handlerData{Name: name, Method: method, Path: routePath}
+
+	for _, match := range pathParam.FindAllStringSubmatch(routePath, -1) {
+		// gorilla/mux parameters can carry a pattern, like {id:[0-9]+}.
+		param, _, _ := strings.Cut(match[1], ":")
+		h.Params = append(h.Params, param)
+	}
+
+	return h, nil
+}
+
+func handlerFiles(h *handlerData) ([]File, error) {
+	file := snake(h.Name)
+
+	var files []File
+
+	for _, name := range []string{"handler.go", "handler_test.go"} {
+		content, err := templates.ReadFile(path.Join("templates", "handler", name+".tmpl"))
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, File{
+			Path:    path.Join(handlerDir, file+strings.TrimPrefix(name, "handler")),
+			Content: content,
+		})
+	}
+
+	files, err := renderFiles(h, "{{", "}}", files)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatGo(files)
+}
+
+// registerHandler adds the route of the handler to main, unless main registers the route already.
+func registerHandler(main *mainFile, module string, h *handlerData) error {
+	app, err := main.app()
+	if err != nil {
+		return err
+	}
+
+	exists, err := main.registered(h.Method, h.Path)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return fmt.Errorf("%w: %s %s", errRouteExists, h.Method, h.Path)
+	}
+
+	err = main.addImport("", module+"/"+handlerDir)
+	if err != nil {
+		return err
+	}
+
+	return main.insert(fmt.Sprintf("%s.%s(%q, %s.%s)", app, h.Method, h.Path, handlerDir, h.Name))
+}
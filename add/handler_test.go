@@ -0,0 +1,71 @@
+package add
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerFiles(t *testing.T) {
+	tests := []struct {
+		desc   string
+		name   string
+		method string
+		path   string
+	}{
+		{"post", "UpdateOrder", "post", "/orders/{id}"},
+		{"get", "GetItem", "", "/orders/{id:[0-9]+}/items/{item}"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			h, err := newHandlerData(tc.name, tc.method, tc.path)
+			require.NoError(t, err)
+
+			files, err := handlerFiles(h)
+			require.NoError(t, err)
+
+			for _, f := range files {
+				assertGolden(t, filepath.Join("testdata", "handler", tc.desc, f.Path), f.Content)
+			}
+		})
+	}
+}
+
+func TestNewHandlerData_Errors(t *testing.T) {
+	tests := []struct {
+		desc   string
+		name   string
+		method string
+		path   string
+		err    error
+	}{
+		{"name missing", "", "GET", "/orders", errHandlerName},
+		{"unexported name", "listOrders", "GET", "/orders", errHandlerName},
+		{"relative path", "ListOrders", "GET", "orders", errHandlerPath},
+		{"unknown method", "ListOrders", "TRACE", "/orders", errHandlerMethod},
+	}
+
+	for i, tc := range tests {
+		_, err := newHandlerData(tc.name, tc.method, tc.path)
+
+		assert.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestRegisterHandler(t *testing.T) {
+	h, err := newHandlerData("UpdateOrder", "POST", "/orders/{id}")
+	require.NoError(t, err)
+
+	main := &mainFile{src: []byte(testMain)}
+
+	require.NoError(t, registerHandler(main, "github.com/acme/shop", h))
+
+	assertGolden(t, filepath.Join("testdata", "handler", "main.go"), main.src)
+
+	err = registerHandler(main, "github.com/acme/shop", h)
+
+	require.ErrorIs(t, err, errRouteExists)
+}
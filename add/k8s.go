@@ -0,0 +1,487 @@
+package add
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"gofr.dev/pkg/gofr"
+)
+
+const (
+	k8sDir    = "k8s"
+	chartsDir = "charts"
+
+	// GoFr serves these endpoints on the HTTP port of every application.
+	aliveEndpoint  = "/.well-known/alive"
+	healthEndpoint = "/.well-known/health"
+
+	deploymentTemplate = `# Generated by gofr add k8s.
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Name }}
+  labels:
+    app.kubernetes.io/name: {{ .Name }}
+spec:
+  replicas: {{ .Replicas }}
+  selector:
+    matchLabels:
+      app.kubernetes.io/name: {{ .Name }}
+  template:
+    metadata:
+      labels:
+        app.kubernetes.io/name: {{ .Name }}
+      annotations:
+        prometheus.io/scrape: "true"
+        prometheus.io/port: "{{ .MetricsPort }}"
+        prometheus.io/path: /metrics
+    spec:
+      containers:
+        - name: {{ .Name }}
+          image: {{ .Image }}
+          ports:
+{{- range .Ports }}
+            - name: {{ .Name }}
+              containerPort: {{ .Port }}
+{{- end }}
+          envFrom:
+            - configMapRef:
+                name: {{ .Name }}-config
+{{- if .Secrets }}
+            - secretRef:
+                name: {{ .Name }}-secret
+{{- end }}
+          livenessProbe:
+            httpGet:
+              path: {{ .AliveEndpoint }}
+              port: http
+            initialDelaySeconds: 5
+            periodSeconds: 10
+          readinessProbe:
+            httpGet:
+              path: {{ .HealthEndpoint }}
+              port: http
+            initialDelaySeconds: 5
+            periodSeconds: 10
+          resources:
+            requests:
+              cpu: 100m
+              memory: 128Mi
+            limits:
+              memory: 256Mi
+`
+
+	serviceTemplate = `# Generated by gofr add k8s.
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Name }}
+  labels:
+    app.kubernetes.io/name: {{ .Name }}
+spec:
+  selector:
+    app.kubernetes.io/name: {{ .Name }}
+  ports:
+{{- range .Ports }}
+    - name: {{ .Name }}
+      port: {{ .Port }}
+      targetPort: {{ .Name }}
+{{- end }}
+`
+
+	configMapTemplate = `# Generated by gofr add k8s from configs/.env.
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Name }}-config
+data:
+{{- range .Config }}
+  {{ .Key }}: {{ quote .Value }}
+{{- else }} {}
+{{- end }}
+`
+
+	secretTemplate = `# Generated by gofr add k8s from the credentials in configs/.env. The values are placeholders, set the real
+# ones before applying it and keep them out of version control, e.g. with kubectl create secret generic.
+apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ .Name }}-secret
+type: Opaque
+stringData:
+{{- range .Secrets }}
+  {{ .Key }}: {{ quote .Value }}
+{{- end }}
+`
+
+	hpaTemplate = `# Generated by gofr add k8s.
+apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata:
+  name: {{ .Name }}
+spec:
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: {{ .Name }}
+  minReplicas: {{ .Replicas }}
+  maxReplicas: {{ .MaxReplicas }}
+  metrics:
+    - type: Resource
+      resource:
+        name: cpu
+        target:
+          type: Utilization
+          averageUtilization: 80
+`
+)
+
+// The helm chart templates are rendered with [[ ]] delimiters, so that the {{ }} actions are left for helm.
+const (
+	chartTemplate = `apiVersion: v2
+name: [[ .Name ]]
+description: Helm chart of [[ .Name ]], generated by gofr add k8s.
+type: application
+version: 0.1.0
+appVersion: "[[ .AppVersion ]]"
+`
+
+	valuesTemplate = `replicaCount: [[ .Replicas ]]
+
+image:
+  repository: [[ .Repository ]]
+  tag: [[ quote .Tag ]]
+  pullPolicy: IfNotPresent
+
+resources:
+  requests:
+    cpu: 100m
+    memory: 128Mi
+  limits:
+    memory: 256Mi
+
+autoscaling:
+  enabled: true
+  minReplicas: [[ .Replicas ]]
+  maxReplicas: [[ .MaxReplicas ]]
+  targetCPUUtilizationPercentage: 80
+
+# config is mounted as environment of the application, it is populated from configs/.env.
+config:
+[[- range .Config ]]
+  [[ .Key ]]: [[ quote .Value ]]
+[[- else ]] {}
+[[- end ]]
+
+# secrets are mounted as environment of the application, the values are placeholders, set the real ones when
+# installing the chart, e.g. with --set secrets.DB_PASSWORD=...
+secrets:
+[[- range .Secrets ]]
+  [[ .Key ]]: [[ quote .Value ]]
+[[- else ]] {}
+[[- end ]]
+`
+
+	helmDeploymentTemplate = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}
+  labels:
+    app.kubernetes.io/name: {{ .Chart.Name }}
+    app.kubernetes.io/instance: {{ .Release.Name }}
+spec:
+  {{- if not .Values.autoscaling.enabled }}
+  replicas: {{ .Values.replicaCount }}
+  {{- end }}
+  selector:
+    matchLabels:
+      app.kubernetes.io/name: {{ .Chart.Name }}
+      app.kubernetes.io/instance: {{ .Release.Name }}
+  template:
+    metadata:
+      labels:
+        app.kubernetes.io/name: {{ .Chart.Name }}
+        app.kubernetes.io/instance: {{ .Release.Name }}
+      annotations:
+        prometheus.io/scrape: "true"
+        prometheus.io/port: "[[ .MetricsPort ]]"
+        prometheus.io/path: /metrics
+        checksum/config: {{ include (print $.Template.BasePath "/configmap.yaml") . | sha256sum }}
+    spec:
+      containers:
+        - name: {{ .Chart.Name }}
+          image: "{{ .Values.image.repository }}:{{ .Values.image.tag }}"
+          imagePullPolicy: {{ .Values.image.pullPolicy }}
+          ports:
+[[- range .Ports ]]
+            - name: [[ .Name ]]
+              containerPort: [[ .Port ]]
+[[- end ]]
+          envFrom:
+            - configMapRef:
+                name: {{ .Release.Name }}-config
+            - secretRef:
+                name: {{ .Release.Name }}-secret
+          livenessProbe:
+            httpGet:
+              path: [[ .AliveEndpoint ]]
+              port: http
+            initialDelaySeconds: 5
+            periodSeconds: 10
+          readinessProbe:
+            httpGet:
+              path: [[ .HealthEndpoint ]]
+              port: http
+            initialDelaySeconds: 5
+            periodSeconds: 10
+          resources:
+            {{- toYaml .Values.resources | nindent 12 }}
+`
+
+	helmServiceTemplate = `apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Release.Name }}
+  labels:
+    app.kubernetes.io/name: {{ .Chart.Name }}
+    app.kubernetes.io/instance: {{ .Release.Name }}
+spec:
+  selector:
+    app.kubernetes.io/name: {{ .Chart.Name }}
+    app.kubernetes.io/instance: {{ .Release.Name }}
+  ports:
+[[- range .Ports ]]
+    - name: [[ .Name ]]
+      port: [[ .Port ]]
+      targetPort: [[ .Name ]]
+[[- end ]]
+`
+
+	helmConfigMapTemplate = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-config
+data:
+  {{- range $key, $value := .Values.config }}
+  {{ $key }}: {{ $value | quote }}
+  {{- end }}
+`
+
+	helmSecretTemplate = `apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ .Release.Name }}-secret
+type: Opaque
+stringData:
+  {{- range $key, $value := .Values.secrets }}
+  {{ $key }}: {{ $value | quote }}
+  {{- end }}
+`
+
+	helmHPATemplate = `{{- if .Values.autoscaling.enabled }}
+apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata:
+  name: {{ .Release.Name }}
+spec:
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: {{ .Release.Name }}
+  minReplicas: {{ .Values.autoscaling.minReplicas }}
+  maxReplicas: {{ .Values.autoscaling.maxReplicas }}
+  metrics:
+    - type: Resource
+      resource:
+        name: cpu
+        target:
+          type: Utilization
+          averageUtilization: {{ .Values.autoscaling.targetCPUUtilizationPercentage }}
+{{- end }}
+`
+)
+
+// secretPlaceholder is the value of the variables of the Secret, the ones of configs/.env are not copied into it.
+const secretPlaceholder = "change-me"
+
+var (
+	errK8sCMD  = errors.New("kubernetes manifests are only generated for applications serving HTTP, not for gofr.NewCMD applications")
+	errK8sName = errors.New("the name of the application is not a valid kubernetes name, it has to be at most 63 " +
+		"lowercase alphanumeric characters or '-', starting and ending with an alphanumeric character")
+)
+
+var dnsLabel = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+type namedPort struct {
+	Name string
+	Port string
+}
+
+type k8sData struct {
+	Name           string
+	Image          string
+	Repository     string
+	Tag            string
+	AppVersion     string
+	Replicas       int
+	MaxReplicas    int
+	MetricsPort    string
+	AliveEndpoint  string
+	HealthEndpoint string
+	Ports          []namedPort
+	Config         []envVar
+	Secrets        []envVar
+}
+
+// K8s generates the kubernetes manifests of the project in the current directory: a Deployment with
+// liveness and readiness probes on GoFr's well-known endpoints, a Service, a HorizontalPodAutoscaler and
+// a ConfigMap populated from configs/.env, the credentials go to a Secret with placeholder values. With -helm a
+// helm chart is generated instead, the image is set with -image and existing files are only overwritten with -force.
+func K8s(ctx *gofr.Context) (any, error) {
+	p, err := LoadProject(".")
+	if err != nil {
+		return nil, err
+	}
+
+	helm := ctx.Param("helm") == "true"
+
+	files, err := K8sFiles(p, ctx.Param("image"), helm)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeFiles(".", files, ctx.Param("force") == "true")
+	if err != nil {
+		return nil, err
+	}
+
+	if helm {
+		return "Successfully generated helm chart in " + path.Join(chartsDir, p.Name), nil
+	}
+
+	return "Successfully generated kubernetes manifests in " + k8sDir, nil
+}
+
+// K8sFiles renders the kubernetes manifests, or the helm chart when helm is set, of the project.
+// The image defaults to <name>:latest.
+func K8sFiles(p *Project, image string, helm bool) ([]File, error) {
+	if p.CMD {
+		return nil, errK8sCMD
+	}
+
+	// the name is used as metadata.name and in the label selectors, which have to be DNS-1123 labels.
+	if len(p.Name) > 63 || !dnsLabel.MatchString(p.Name) {
+		return nil, fmt.Errorf("%w, got %q", errK8sName, p.Name)
+	}
+
+	data := newK8sData(p, image)
+
+	if helm {
+		dir := path.Join(chartsDir, p.Name)
+
+		return renderFiles(data, "[[", "]]", []File{
+			{Path: path.Join(dir, "Chart.yaml"), Content: []byte(chartTemplate)},
+			{Path: path.Join(dir, "values.yaml"), Content: []byte(valuesTemplate)},
+			{Path: path.Join(dir, "templates", "deployment.yaml"), Content: []byte(helmDeploymentTemplate)},
+			{Path: path.Join(dir, "templates", "service.yaml"), Content: []byte(helmServiceTemplate)},
+			{Path: path.Join(dir, "templates", "configmap.yaml"), Content: []byte(helmConfigMapTemplate)},
+			{Path: path.Join(dir, "templates", "secret.yaml"), Content: []byte(helmSecretTemplate)},
+			{Path: path.Join(dir, "templates", "hpa.yaml"), Content: []byte(helmHPATemplate)},
+		})
+	}
+
+	manifests := []File{
+		{Path: path.Join(k8sDir, "deployment.yaml"), Content: []byte(deploymentTemplate)},
+		{Path: path.Join(k8sDir, "service.yaml"), Content: []byte(serviceTemplate)},
+		{Path: path.Join(k8sDir, "configmap.yaml"), Content: []byte(configMapTemplate)},
+		{Path: path.Join(k8sDir, "hpa.yaml"), Content: []byte(hpaTemplate)},
+	}
+
+	if len(data.Secrets) > 0 {
+		manifests = append(manifests, File{Path: path.Join(k8sDir, "secret.yaml"), Content: []byte(secretTemplate)})
+	}
+
+	return renderFiles(data, "{{", "}}", manifests)
+}
+
+func newK8sData(p *Project, image string) *k8sData {
+	if image == "" {
+		image = p.Name + ":latest"
+	}
+
+	repository, tag := image, "latest"
+
+	// the tag follows the last colon, unless that colon separates a registry port.
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		repository, tag = image[:i], image[i+1:]
+	}
+
+	data := &k8sData{
+		Name:           p.Name,
+		Image:          image,
+		Repository:     repository,
+		Tag:            tag,
+		AppVersion:     p.Env.GetOrDefault("APP_VERSION", tag),
+		Replicas:       2,
+		MaxReplicas:    10,
+		MetricsPort:    p.Env.GetOrDefault("METRICS_PORT", defaultMetricsPort),
+		AliveEndpoint:  aliveEndpoint,
+		HealthEndpoint: healthEndpoint,
+		Ports:          []namedPort{{"http", p.Env.GetOrDefault("HTTP_PORT", defaultHTTPPort)}},
+	}
+
+	if grpcPort := p.Env.Get("GRPC_PORT"); grpcPort != "" {
+		data.Ports = append(data.Ports, namedPort{"grpc", grpcPort})
+	}
+
+	data.Ports = append(data.Ports, namedPort{"metrics", data.MetricsPort})
+
+	for _, key := range p.Env.Keys() {
+		if isSecret(key) {
+			data.Secrets = append(data.Secrets, envVar{key, secretPlaceholder})
+		} else {
+			data.Config = append(data.Config, envVar{key, p.Env.Get(key)})
+		}
+	}
+
+	return data
+}
+
+// isSecret reports whether the variable holds a credential, which belongs in a Secret instead of the ConfigMap.
+func isSecret(key string) bool {
+	for _, s := range []string{"PASSWORD", "SECRET", "TOKEN", "API_KEY", "PRIVATE_KEY"} {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// renderFiles executes the content of every file as a template using the given delimiters.
+func renderFiles(data any, left, right string, files []File) ([]File, error) {
+	for i, f := range files {
+		t, err := template.New(f.Path).Delims(left, right).Funcs(template.FuncMap{"quote": strconv.Quote}).
+			Parse(string(f.Content))
+		if err != nil {
+			return nil, err
+		}
+
+		var buf bytes.Buffer
+
+		err = t.Execute(&buf, data)
+		if err != nil {
+			return nil, err
+		}
+
+		files[i].Content = buf.Bytes()
+	}
+
+	return files, nil
+}
@@ -0,0 +1,36 @@
+package add
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/cli/gofr/envfile"
+)
+
+func TestK8sFiles(t *testing.T) {
+	env, err := envfile.Parse(strings.NewReader("APP_NAME=orders\nAPP_VERSION=1.2.0\nHTTP_PORT=8000\nGRPC_PORT=9000\n" +
+		"METRICS_PORT=2121\nDB_DIALECT=mysql\nDB_HOST=mysql\nDB_PASSWORD=secret\n"))
+	require.NoError(t, err)
+
+	p := &Project{Name: "orders", Env: env}
+
+	for _, helm := range []bool{false, true} {
+		files, err := K8sFiles(p, "registry.example.com:5000/acme/orders:1.2.0", helm)
+		require.NoError(t, err)
+
+		for _, f := range files {
+			assertGolden(t, filepath.Join("testdata", "k8s", f.Path), f.Content)
+		}
+	}
+
+	_, err = K8sFiles(&Project{Name: "importer", CMD: true, Env: env}, "", false)
+	require.ErrorIs(t, err, errK8sCMD)
+
+	for _, name := range []string{"order_service", "Orders", "-orders", strings.Repeat("a", 64)} {
+		_, err = K8sFiles(&Project{Name: name, Env: env}, "", false)
+		require.ErrorIs(t, err, errK8sName, name)
+	}
+}
@@ -0,0 +1,403 @@
+package add
+
+import (
+	"bytes"
+	"errors"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+const gofrPackage = "gofr.dev/pkg/gofr"
+
+var (
+	errNoMain = errors.New("main.go has no main function")
+	errNoApp  = errors.New("main.go does not create the app with gofr.New() or gofr.NewCMD()")
+)
+
+// mainFile is the main.go of a project being edited by the add commands. Imports are added to its
+// syntax tree and statements are inserted at the positions found in it, so that the comments and the
+// formatting of the rest of the file are preserved.
+type mainFile struct {
+	src []byte
+}
+
+func readMain(dir string) (*mainFile, error) {
+	src, err := os.ReadFile(filepath.Join(dir, "main.go"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errNoMain
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mainFile{src: src}, nil
+}
+
+func (m *mainFile) parse() (*token.FileSet, *ast.File, *ast.FuncDecl, error) {
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, "main.go", m.src, parser.ParseComments)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.Name == "main" && fn.Recv == nil && fn.Body != nil {
+			return fset, f, fn, nil
+		}
+	}
+
+	return nil, nil, nil, errNoMain
+}
+
+// app returns the name of the variable main assigns the app created with gofr.New or gofr.NewCMD to.
+func (m *mainFile) app() (string, error) {
+	_, f, fn, err := m.parse()
+	if err != nil {
+		return "", err
+	}
+
+	if name := appName(f, fn); name != "" {
+		return name, nil
+	}
+
+	return "", errNoApp
+}
+
+func appName(f *ast.File, fn *ast.FuncDecl) string {
+	gofr := importName(f, gofrPackage)
+
+	for _, stmt := range fn.Body.List {
+		assign, ok := stmt.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			continue
+		}
+
+		call, ok := assign.Rhs[0].(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && isIdent(sel.X, gofr) &&
+			(sel.Sel.Name == "New" || sel.Sel.Name == "NewCMD") {
+			if ident, ok := assign.Lhs[0].(*ast.Ident); ok {
+				return ident.Name
+			}
+		}
+	}
+
+	return ""
+}
+
+// importName returns the name the file refers to the package imported from path with.
+func importName(f *ast.File, path string) string {
+	for _, spec := range f.Imports {
+		if p, _ := strconv.Unquote(spec.Path.Value); p == path {
+			if spec.Name != nil {
+				return spec.Name.Name
+			}
+
+			return filepath.Base(path)
+		}
+	}
+
+	return ""
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+
+	return ok && ident.Name == name
+}
+
+// contains reports whether main has the given code already, ignoring the formatting.
+func (m *mainFile) contains(code string) bool {
+	return strings.Contains(stripSpace(string(m.src)), stripSpace(code))
+}
+
+// insert adds the code, one or more statements, to main before the app is run, or at its end when it
+// does not run the app. Code which main has already is not added again, so that adding twice is a no-op.
+func (m *mainFile) insert(code string) error {
+	if m.contains(code) {
+		return nil
+	}
+
+	fset, f, fn, err := m.parse()
+	if err != nil {
+		return err
+	}
+
+	pos, beforeRun := fn.Body.Rbrace, false
+	app := appName(f, fn)
+
+	for _, stmt := range fn.Body.List {
+		if isRun(stmt, app) {
+			pos, beforeRun = stmt.Pos(), true
+			break
+		}
+	}
+
+	start := lineStart(m.src, fset.Position(pos).Offset)
+
+	var buf bytes.Buffer
+
+	buf.Write(m.src[:start])
+	buf.WriteString("\n")
+
+	for _, line := range strings.Split(strings.TrimSpace(code), "\n") {
+		buf.WriteString("\t" + line + "\n")
+	}
+
+	if beforeRun {
+		buf.WriteString("\n")
+	}
+
+	buf.Write(m.src[start:])
+
+	return m.setSource(buf.Bytes())
+}
+
+// registered reports whether main calls the method of the app with the given first argument,
+// e.g. registered("GET", "/orders") for app.GET("/orders", handler).
+func (m *mainFile) registered(method, arg string) (bool, error) {
+	return m.calls(method, func(args []ast.Expr) bool {
+		value, ok := stringArg(args, 0)
+
+		return ok && value == arg
+	})
+}
+
+// stringArg returns the value of the i-th argument when it is a string literal.
+func stringArg(args []ast.Expr, i int) (string, bool) {
+	if i >= len(args) {
+		return "", false
+	}
+
+	lit, ok := args[i].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+
+	value, err := strconv.Unquote(lit.Value)
+
+	return value, err == nil
+}
+
+// calls reports whether main calls the method of the app with arguments accepted by match, which may be
+// nil to accept any arguments.
+func (m *mainFile) calls(method string, match func(args []ast.Expr) bool) (bool, error) {
+	_, f, fn, err := m.parse()
+	if err != nil {
+		return false, err
+	}
+
+	app, found := appName(f, fn), false
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			sel, isSel := call.Fun.(*ast.SelectorExpr)
+			found = isSel && isIdent(sel.X, app) && sel.Sel.Name == method && (match == nil || match(call.Args))
+		}
+
+		return !found
+	})
+
+	return found, nil
+}
+
+func isRun(stmt ast.Stmt, app string) bool {
+	expr, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+
+	call, ok := expr.X.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+
+	return ok && isIdent(sel.X, app) && sel.Sel.Name == "Run"
+}
+
+// addImport imports the package at path, under name unless name is empty. The import is added to the
+// group of the import block holding the standard library, respectively the packages of the same host,
+// or to a new group when there is none, like goimports and gci group them.
+func (m *mainFile) addImport(name, path string) error {
+	fset, f, _, err := m.parse()
+	if err != nil {
+		return err
+	}
+
+	if importName(f, path) != "" {
+		return nil
+	}
+
+	var decl *ast.GenDecl
+
+	for _, d := range f.Decls {
+		if gen, ok := d.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			decl = gen
+			break
+		}
+	}
+
+	if decl == nil || !decl.Lparen.IsValid() || len(decl.Specs) == 0 {
+		return m.addImportDecl(fset, f, name, path)
+	}
+
+	spec := strconv.Quote(path)
+	if name != "" {
+		spec = name + " " + spec
+	}
+
+	groups := importGroups(fset, decl)
+
+	var src []byte
+
+	if group := matchingGroup(groups, path); group != nil {
+		src = insertAt(m.src, lineEnd(m.src, fset.Position(group[len(group)-1].End()).Offset), "\n\t"+spec)
+	} else if isStdlib(path) {
+		src = insertAt(m.src, lineStart(m.src, fset.Position(decl.Specs[0].Pos()).Offset), "\t"+spec+"\n\n")
+	} else {
+		last := decl.Specs[len(decl.Specs)-1]
+		src = insertAt(m.src, lineEnd(m.src, fset.Position(last.End()).Offset), "\n\n\t"+spec)
+	}
+
+	return m.setSource(src)
+}
+
+// addImportDecl adds the import to a file which has no parenthesized import block.
+func (m *mainFile) addImportDecl(fset *token.FileSet, f *ast.File, name, path string) error {
+	astutil.AddNamedImport(fset, f, name, path)
+
+	var buf bytes.Buffer
+
+	err := format.Node(&buf, fset, f)
+	if err != nil {
+		return err
+	}
+
+	return m.setSource(buf.Bytes())
+}
+
+// importGroups splits the imports of decl into the groups separated by blank lines.
+func importGroups(fset *token.FileSet, decl *ast.GenDecl) [][]*ast.ImportSpec {
+	var (
+		groups  [][]*ast.ImportSpec
+		endLine int
+	)
+
+	for _, s := range decl.Specs {
+		spec := s.(*ast.ImportSpec)
+
+		if line := fset.Position(spec.Pos()).Line; len(groups) == 0 || line > endLine+1 {
+			groups = append(groups, nil)
+		}
+
+		groups[len(groups)-1] = append(groups[len(groups)-1], spec)
+		endLine = fset.Position(spec.End()).Line
+	}
+
+	return groups
+}
+
+// matchingGroup returns the group path belongs to, the group of the standard library for its packages,
+// otherwise the group with the longest common prefix among the groups importing packages of the same host.
+func matchingGroup(groups [][]*ast.ImportSpec, path string) []*ast.ImportSpec {
+	var (
+		match  []*ast.ImportSpec
+		length int
+	)
+
+	host, _, _ := strings.Cut(path, "/")
+
+	for _, group := range groups {
+		for _, spec := range group {
+			p, _ := strconv.Unquote(spec.Path.Value)
+
+			if isStdlib(path) != isStdlib(p) || !isStdlib(path) && !strings.HasPrefix(p+"/", host+"/") {
+				continue
+			}
+
+			if n := commonPrefix(p, path); match == nil || n > length {
+				match, length = group, n
+			}
+		}
+	}
+
+	return match
+}
+
+func isStdlib(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+
+	return !strings.Contains(first, ".")
+}
+
+func commonPrefix(a, b string) int {
+	n := 0
+
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+
+	return n
+}
+
+func lineStart(src []byte, offset int) int {
+	return bytes.LastIndexByte(src[:offset], '\n') + 1
+}
+
+func lineEnd(src []byte, offset int) int {
+	if i := bytes.IndexByte(src[offset:], '\n'); i >= 0 {
+		return offset + i
+	}
+
+	return len(src)
+}
+
+func insertAt(src []byte, offset int, text string) []byte {
+	out := make([]byte, 0, len(src)+len(text))
+	out = append(out, src[:offset]...)
+	out = append(out, text...)
+
+	return append(out, src[offset:]...)
+}
+
+// setSource replaces the source of main.go by the formatted src, which has to be valid Go.
+func (m *mainFile) setSource(src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return err
+	}
+
+	m.src = formatted
+
+	return nil
+}
+
+func (m *mainFile) file() File {
+	return File{Path: "main.go", Content: m.src}
+}
+
+func stripSpace(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+
+		return r
+	}, s)
+}
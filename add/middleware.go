@@ -0,0 +1,128 @@
+package add
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+)
+
+const middlewareDir = "middleware"
+
+var (
+	errMiddlewareName   = errors.New(`please provide the name of the middleware, an exported Go identifier, using the "-name" option`)
+	errMiddlewareExists = errors.New("main.go uses the middleware already")
+	errMiddlewareCMD    = errors.New("command line applications can not use middlewares")
+)
+
+type middlewareData struct {
+	Name string
+}
+
+// Middleware generates an HTTP middleware with its test in the middleware package and adds it to the app
+// with app.UseMiddleware in main.go, e.g.
+//
+//	gofr add middleware -name=RequestTimer
+func Middleware(ctx *gofr.Context) (any, error) {
+	p, err := LoadProject(".")
+	if err != nil {
+		return nil, err
+	}
+
+	name := ctx.Param("name")
+	if !token.IsIdentifier(name) || !token.IsExported(name) {
+		return nil, errMiddlewareName
+	}
+
+	d := &middlewareData{Name: name}
+
+	main, err := readMain(".")
+	if err != nil {
+		return nil, err
+	}
+
+	err = registerMiddleware(main, p, d)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := middlewareFiles(d)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeChanges(".", files, []File{main.file()}, ctx.Param("force") == "true")
+	if err != nil {
+		return nil, err
+	}
+
+	return fmt.Sprintf("Successfully added middleware %s", d.Name), nil
+}
+
+func middlewareFiles(d *middlewareData) ([]File, error) {
+	file := snake(d.Name)
+
+	var files []File
+
+	for _, name := range []string{"middleware.go", "middleware_test.go"} {
+		content, err := templates.ReadFile(path.Join("templates", "middleware", name+".tmpl"))
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, File{
+			Path:    path.Join(middlewareDir, file+strings.TrimPrefix(name, "middleware")),
+			Content: content,
+		})
+	}
+
+	files, err := renderFiles(d, "{{", "}}", files)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatGo(files)
+}
+
+// registerMiddleware adds the middleware to the app in main, unless main uses it already.
+func registerMiddleware(main *mainFile, p *Project, d *middlewareData) error {
+	if p.CMD {
+		return errMiddlewareCMD
+	}
+
+	app, err := main.app()
+	if err != nil {
+		return err
+	}
+
+	call := fmt.Sprintf("%s.%s()", middlewareDir, d.Name)
+
+	exists, err := main.calls("UseMiddleware", func(args []ast.Expr) bool {
+		for _, arg := range args {
+			if types.ExprString(arg) == call {
+				return true
+			}
+		}
+
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return fmt.Errorf("%w: %s", errMiddlewareExists, call)
+	}
+
+	err = main.addImport("", p.Module+"/"+middlewareDir)
+	if err != nil {
+		return err
+	}
+
+	return main.insert(fmt.Sprintf("%s.UseMiddleware(%s)", app, call))
+}
@@ -0,0 +1,29 @@
+package add
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddMiddleware(t *testing.T) {
+	d := &middlewareData{Name: "RequestTimer"}
+
+	files, err := middlewareFiles(d)
+	require.NoError(t, err)
+
+	for _, f := range files {
+		assertGolden(t, filepath.Join("testdata", "middleware", f.Path), f.Content)
+	}
+
+	main := &mainFile{src: []byte(testMain)}
+
+	require.NoError(t, registerMiddleware(main, testProject(t, ""), d))
+
+	assertGolden(t, filepath.Join("testdata", "middleware", "main.go"), main.src)
+
+	err = registerMiddleware(main, testProject(t, ""), d)
+
+	require.ErrorIs(t, err, errMiddlewareExists)
+}
@@ -0,0 +1,215 @@
+// Package add implements the `gofr add` commands, which generate additional files for an existing GoFr project.
+package add
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+
+	"gofr.dev/cli/gofr/envfile"
+)
+
+const (
+	fileMode = 0644
+	dirMode  = 0755
+
+	envFile = "configs/.env"
+)
+
+var (
+	errNotAProject = errors.New("go.mod not found, please run the command from the root of a GoFr project")
+	errFilesExist  = errors.New("files already exist, use -force to overwrite them")
+)
+
+// templates holds the Go files generated by the add commands.
+//
+//go:embed templates
+var templates embed.FS
+
+// Project is the GoFr project files are generated for.
+type Project struct {
+	// Module is the module path from go.mod.
+	Module string
+	// Name is the APP_NAME of the project, defaulting to the last element of the module path.
+	Name string
+	// GoVersion is the go directive from go.mod.
+	GoVersion string
+	// CMD reports whether the project is a command line application created with gofr.NewCMD.
+	CMD bool
+	// Static reports whether the project serves files from the static directory.
+	Static bool
+	// Env holds the variables of configs/.env, it is empty when the file does not exist.
+	Env *envfile.Env
+}
+
+// File is a generated file, Path is relative to the project root.
+type File struct {
+	Path    string
+	Content []byte
+}
+
+// LoadProject reads the project rooted at dir.
+func LoadProject(dir string) (*Project, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errNotAProject
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	mod, err := modfile.ParseLax("go.mod", data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Project{Env: &envfile.Env{}}
+
+	if mod.Module != nil {
+		p.Module = mod.Module.Mod.Path
+	}
+
+	if mod.Go != nil {
+		p.GoVersion = mod.Go.Version
+	}
+
+	if env, envErr := envfile.Read(filepath.Join(dir, filepath.FromSlash(envFile))); envErr == nil {
+		p.Env = env
+	}
+
+	p.Name = p.Env.GetOrDefault("APP_NAME", path.Base(p.Module))
+
+	if main, mainErr := os.ReadFile(filepath.Join(dir, "main.go")); mainErr == nil {
+		p.CMD = strings.Contains(string(main), "gofr.NewCMD()")
+	}
+
+	if info, statErr := os.Stat(filepath.Join(dir, "static")); statErr == nil {
+		p.Static = info.IsDir()
+	}
+
+	return p, nil
+}
+
+// writeChanges writes the generated files below dir and then the updated ones, like main.go, which are
+// overwritten without -force as the add commands only add code to them.
+func writeChanges(dir string, generated, updated []File, force bool) error {
+	err := writeFiles(dir, generated, force)
+	if err != nil {
+		return err
+	}
+
+	return writeFiles(dir, updated, true)
+}
+
+// writeFiles writes the files below dir. Existing files are only overwritten when force is set.
+func writeFiles(dir string, files []File, force bool) error {
+	if !force {
+		var existing []string
+
+		for _, f := range files {
+			if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(f.Path))); err == nil {
+				existing = append(existing, f.Path)
+			}
+		}
+
+		if len(existing) > 0 {
+			return fmt.Errorf("%w: %s", errFilesExist, strings.Join(existing, ", "))
+		}
+	}
+
+	for _, f := range files {
+		p := filepath.Join(dir, filepath.FromSlash(f.Path))
+
+		if err := os.MkdirAll(filepath.Dir(p), dirMode); err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(p, f.Content, fileMode); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addEnv appends the variables configs/.env does not define yet to it, below the comment. It returns
+// nil when the file defines all of them already.
+func addEnv(dir string, p *Project, comment string, vars []envVar) (*File, error) {
+	var missing []envVar
+
+	for _, v := range vars {
+		if _, ok := p.Env.Lookup(v.Key); !ok {
+			missing = append(missing, v)
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(envFile)))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	var b strings.Builder
+
+	b.Write(content)
+
+	if len(content) > 0 {
+		if content[len(content)-1] != '\n' {
+			b.WriteString("\n")
+		}
+
+		b.WriteString("\n")
+	}
+
+	b.WriteString("# " + comment + "\n")
+
+	for _, v := range missing {
+		b.WriteString(v.Key + "=" + v.Value + "\n")
+	}
+
+	return &File{Path: envFile, Content: []byte(b.String())}, nil
+}
+
+// requireModule adds a requirement of mod at version to go.mod. It returns nil when go.mod requires
+// the module already.
+func requireModule(dir, mod, version string) (*File, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := modfile.Parse("go.mod", data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range f.Require {
+		if r.Mod.Path == mod {
+			return nil, nil
+		}
+	}
+
+	err = f.AddRequire(mod, version)
+	if err != nil {
+		return nil, err
+	}
+
+	f.Cleanup()
+
+	content, err := f.Format()
+	if err != nil {
+		return nil, err
+	}
+
+	return &File{Path: "go.mod", Content: content}, nil
+}
@@ -0,0 +1,206 @@
+package add
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"path"
+	"regexp"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+)
+
+const (
+	subscriberDir = "subscriber"
+	publisherDir  = "publisher"
+)
+
+var (
+	errTopic              = errors.New(`please provide the topic to subscribe to using the "-topic" option`)
+	errSubscriberName     = errors.New(`unable to name the subscriber after the topic, please provide the name using the "-name" option`)
+	errUnknownBackend     = errors.New("unknown pub/sub backend, supported backends are kafka, google and mqtt")
+	errBackendMismatch    = errors.New("configs/.env configures a different pub/sub backend")
+	errSubscriptionExists = errors.New("main.go subscribes to the topic already")
+	errSubscribeCMD       = errors.New("command line applications can not subscribe to topics")
+)
+
+var nonIdentifier = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+//nolint:gochecknoglobals // configs/.env variables of the pub/sub backends, the project name replaces %s.
+var pubsubBackends = map[string][]envVar{
+	"kafka": {
+		{"PUBSUB_BACKEND", "KAFKA"},
+		{"PUBSUB_BROKER", "localhost:9092"},
+		{"CONSUMER_ID", "%s"},
+	},
+	"google": {
+		{"PUBSUB_BACKEND", "GOOGLE"},
+		{"GOOGLE_PROJECT_ID", "%s"},
+		{"GOOGLE_SUBSCRIPTION_NAME", "%s-subscription"},
+	},
+	"mqtt": {
+		{"PUBSUB_BACKEND", "MQTT"},
+		{"MQTT_HOST", "localhost"},
+		{"MQTT_PORT", "1883"},
+		{"MQTT_QOS", "1"},
+	},
+}
+
+type subscriberData struct {
+	Module string
+	Name   string
+	Topic  string
+}
+
+// Subscriber generates a subscriber binding the messages of a topic into a struct, with its test, and
+// subscribes it to the topic in main.go, e.g.
+//
+//	gofr add subscriber -topic=order-created -backend=kafka
+//
+// The subscriber is named after the topic unless -name is given. The variables of the backend, kafka by
+// default, are added to configs/.env, and -publisher generates a function publishing on the topic as well.
+func Subscriber(ctx *gofr.Context) (any, error) {
+	p, err := LoadProject(".")
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := newSubscriberData(p, ctx.Param("topic"), ctx.Param("name"))
+	if err != nil {
+		return nil, err
+	}
+
+	main, err := readMain(".")
+	if err != nil {
+		return nil, err
+	}
+
+	err = registerSubscriber(main, p, d)
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := pubsubEnv(".", p, ctx.Param("backend"))
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := subscriberFiles(d, ctx.Param("publisher") == "true")
+	if err != nil {
+		return nil, err
+	}
+
+	updated := []File{main.file()}
+	if env != nil {
+		updated = append(updated, *env)
+	}
+
+	err = writeChanges(".", files, updated, ctx.Param("force") == "true")
+	if err != nil {
+		return nil, err
+	}
+
+	return fmt.Sprintf("Successfully added subscriber %s for topic %s", d.Name, d.Topic), nil
+}
+
+func newSubscriberData(p *Project, topic, name string) (*subscriberData, error) {
+	if topic == "" {
+		return nil, errTopic
+	}
+
+	if name == "" {
+		name = exported(strings.Trim(nonIdentifier.ReplaceAllString(topic, "_"), "_"))
+	}
+
+	if !token.IsIdentifier(name) || !token.IsExported(name) {
+		return nil, errSubscriberName
+	}
+
+	return &subscriberData{Module: p.Module, Name: name, Topic: topic}, nil
+}
+
+func subscriberFiles(d *subscriberData, publisher bool) ([]File, error) {
+	file := snake(d.Name)
+
+	// template and generated file, relative to the project root.
+	generated := [][2]string{
+		{"subscriber.go", path.Join(subscriberDir, file+".go")},
+		{"subscriber_test.go", path.Join(subscriberDir, file+"_test.go")},
+	}
+
+	if publisher {
+		generated = append(generated, [2]string{"publisher.go", path.Join(publisherDir, file+".go")})
+	}
+
+	files := make([]File, 0, len(generated))
+
+	for _, g := range generated {
+		content, err := templates.ReadFile(path.Join("templates", "subscriber", g[0]+".tmpl"))
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, File{Path: g[1], Content: content})
+	}
+
+	files, err := renderFiles(d, "{{", "}}", files)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatGo(files)
+}
+
+// registerSubscriber subscribes the subscriber to its topic in main, unless main subscribes to the topic already.
+func registerSubscriber(main *mainFile, p *Project, d *subscriberData) error {
+	if p.CMD {
+		return errSubscribeCMD
+	}
+
+	app, err := main.app()
+	if err != nil {
+		return err
+	}
+
+	exists, err := main.registered("Subscribe", d.Topic)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return fmt.Errorf("%w: %s", errSubscriptionExists, d.Topic)
+	}
+
+	err = main.addImport("", p.Module+"/"+subscriberDir)
+	if err != nil {
+		return err
+	}
+
+	return main.insert(fmt.Sprintf("%s.Subscribe(%q, %s.%s)", app, d.Topic, subscriberDir, d.Name))
+}
+
+// pubsubEnv adds the variables of the backend to configs/.env, failing when the file configures another backend.
+func pubsubEnv(dir string, p *Project, backend string) (*File, error) {
+	backend = strings.ToLower(backend)
+	if backend == "" {
+		backend = "kafka"
+	}
+
+	defaults, ok := pubsubBackends[backend]
+	if !ok {
+		return nil, fmt.Errorf("%w, got %q", errUnknownBackend, backend)
+	}
+
+	if configured := p.Env.Get("PUBSUB_BACKEND"); configured != "" && !strings.EqualFold(configured, backend) {
+		return nil, fmt.Errorf("%w: %s", errBackendMismatch, configured)
+	}
+
+	vars := make([]envVar, 0, len(defaults))
+
+	for _, v := range defaults {
+		vars = append(vars, envVar{v.Key, strings.ReplaceAll(v.Value, "%s", p.Name)})
+	}
+
+	return addEnv(dir, p, "Pub/Sub, PUBSUB_BACKEND is one of KAFKA, GOOGLE or MQTT.", vars)
+}
@@ -0,0 +1,73 @@
+package add
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriberFiles(t *testing.T) {
+	d, err := newSubscriberData(testProject(t, ""), "order-created", "")
+	require.NoError(t, err)
+	assert.Equal(t, "OrderCreated", d.Name)
+
+	files, err := subscriberFiles(d, true)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+
+	for _, f := range files {
+		assertGolden(t, filepath.Join("testdata", "subscriber", f.Path), f.Content)
+	}
+
+	main := &mainFile{src: []byte(testMain)}
+
+	require.NoError(t, registerSubscriber(main, testProject(t, ""), d))
+
+	assertGolden(t, filepath.Join("testdata", "subscriber", "main.go"), main.src)
+
+	err = registerSubscriber(main, testProject(t, ""), d)
+
+	require.ErrorIs(t, err, errSubscriptionExists)
+}
+
+func TestPubsubEnv(t *testing.T) {
+	tests := []struct {
+		desc    string
+		env     string
+		backend string
+		want    string
+		err     error
+	}{
+		{"kafka by default", "APP_NAME=orders", "", "APP_NAME=orders\n\n# Pub/Sub, PUBSUB_BACKEND is one of KAFKA, GOOGLE or MQTT.\n" +
+			"PUBSUB_BACKEND=KAFKA\nPUBSUB_BROKER=localhost:9092\nCONSUMER_ID=orders\n", nil},
+		{"configured already", "PUBSUB_BACKEND=MQTT\nMQTT_HOST=localhost\nMQTT_PORT=1883\nMQTT_QOS=1", "mqtt", "", nil},
+		{"other backend", "PUBSUB_BACKEND=KAFKA", "google", "", errBackendMismatch},
+		{"unknown backend", "", "nats", "", errUnknownBackend},
+	}
+
+	for i, tc := range tests {
+		dir := t.TempDir()
+
+		if tc.env != "" {
+			require.NoError(t, os.MkdirAll(filepath.Join(dir, "configs"), dirMode))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, envFile), []byte(tc.env), fileMode))
+		}
+
+		p := testProject(t, tc.env)
+		p.Name = "orders"
+
+		f, err := pubsubEnv(dir, p, tc.backend)
+
+		require.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		if tc.want == "" {
+			assert.Nil(t, f, "TEST[%d], Failed.\n%s", i, tc.desc)
+			continue
+		}
+
+		assert.Equal(t, tc.want, string(f.Content), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
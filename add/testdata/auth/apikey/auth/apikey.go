@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"gofr.dev/pkg/gofr/container"
+)
+
+// ValidateAPIKey reports whether apiKey is one of the comma separated API_KEYS of the configuration.
+// Replace it to look the keys up elsewhere, e.g. in a datasource of the container.
+func ValidateAPIKey(c *container.Container, apiKey string) bool {
+	for _, key := range strings.Split(c.Config.Get("API_KEYS"), ",") {
+		key = strings.TrimSpace(key)
+
+		if key != "" && subtle.ConstantTimeCompare([]byte(key), []byte(apiKey)) == 1 {
+			return true
+		}
+	}
+
+	return false
+}
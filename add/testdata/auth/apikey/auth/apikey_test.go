@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gofr.dev/pkg/gofr/config"
+	"gofr.dev/pkg/gofr/container"
+)
+
+func TestValidateAPIKey(t *testing.T) {
+	c := &container.Container{Config: config.NewMockConfig(map[string]string{"API_KEYS": "first-key, second-key"})}
+
+	tests := []struct {
+		desc  string
+		key   string
+		valid bool
+	}{
+		{"first key", "first-key", true},
+		{"second key", "second-key", true},
+		{"unknown key", "third-key", false},
+		{"empty key", "", false},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.valid, ValidateAPIKey(c, tc.key), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
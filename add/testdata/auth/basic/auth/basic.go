@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"crypto/subtle"
+
+	"gofr.dev/pkg/gofr/container"
+)
+
+// ValidateBasicAuth reports whether the credentials are the BASIC_AUTH_USERNAME and BASIC_AUTH_PASSWORD of the
+// configuration. Replace it to look the users up elsewhere, e.g. in a datasource of the container.
+func ValidateBasicAuth(c *container.Container, username, password string) bool {
+	wantUser, wantPassword := c.Config.Get("BASIC_AUTH_USERNAME"), c.Config.Get("BASIC_AUTH_PASSWORD")
+
+	if wantUser == "" || wantPassword == "" {
+		return false
+	}
+
+	userMatch := subtle.ConstantTimeCompare([]byte(username), []byte(wantUser))
+	passwordMatch := subtle.ConstantTimeCompare([]byte(password), []byte(wantPassword))
+
+	return userMatch&passwordMatch == 1
+}
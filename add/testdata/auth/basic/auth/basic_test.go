@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gofr.dev/pkg/gofr/config"
+	"gofr.dev/pkg/gofr/container"
+)
+
+func TestValidateBasicAuth(t *testing.T) {
+	c := &container.Container{Config: config.NewMockConfig(map[string]string{
+		"BASIC_AUTH_USERNAME": "admin",
+		"BASIC_AUTH_PASSWORD": "secret",
+	})}
+
+	tests := []struct {
+		desc     string
+		username string
+		password string
+		valid    bool
+	}{
+		{"valid credentials", "admin", "secret", true},
+		{"wrong password", "admin", "guess", false},
+		{"unknown user", "guest", "secret", false},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.valid, ValidateBasicAuth(c, tc.username, tc.password), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/acme/shop/auth"
+)
+
+// main starts the shop.
+func main() {
+	app := gofr.New()
+
+	// health of the shop
+	app.GET("/ping", func(*gofr.Context) (any, error) { return "pong", nil })
+
+	app.EnableBasicAuthWithValidator(auth.ValidateBasicAuth)
+
+	app.Run() // blocks
+}
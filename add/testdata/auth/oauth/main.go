@@ -0,0 +1,24 @@
+package main
+
+import (
+	"strconv"
+
+	"gofr.dev/pkg/gofr"
+)
+
+// main starts the shop.
+func main() {
+	app := gofr.New()
+
+	// health of the shop
+	app.GET("/ping", func(*gofr.Context) (any, error) { return "pong", nil })
+
+	jwksRefreshInterval, err := strconv.Atoi(app.Config.Get("OAUTH_JWKS_REFRESH_INTERVAL"))
+	if err != nil {
+		app.Logger().Fatalf("invalid OAUTH_JWKS_REFRESH_INTERVAL: %v", err)
+	}
+
+	app.EnableOAuth(app.Config.Get("OAUTH_JWKS_URL"), jwksRefreshInterval)
+
+	app.Run() // blocks
+}
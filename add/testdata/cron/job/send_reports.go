@@ -0,0 +1,11 @@
+package job
+
+import (
+	"gofr.dev/pkg/gofr"
+)
+
+// SendReports is executed on every tick of the send-reports cron job, scheduled "0 9 * * 1".
+// Jobs have no caller to return errors to, so failures must be logged.
+func SendReports(ctx *gofr.Context) {
+	ctx.Logger.Info("send-reports started")
+}
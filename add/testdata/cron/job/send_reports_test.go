@@ -0,0 +1,18 @@
+package job
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+)
+
+func TestSendReports(t *testing.T) {
+	c, _ := container.NewMockContainer(t)
+
+	ctx := &gofr.Context{Context: context.Background(), Container: c}
+
+	assert.NotPanics(t, func() { SendReports(ctx) })
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/acme/shop/job"
+)
+
+// main starts the shop.
+func main() {
+	app := gofr.New()
+
+	// health of the shop
+	app.GET("/ping", func(*gofr.Context) (any, error) { return "pong", nil })
+
+	app.AddCronJob("0 9 * * 1", "send-reports", job.SendReports)
+
+	app.Run() // blocks
+}
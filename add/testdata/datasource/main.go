@@ -0,0 +1,31 @@
+package main
+
+import (
+	"strconv"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource/cassandra"
+)
+
+// main starts the shop.
+func main() {
+	app := gofr.New()
+
+	// health of the shop
+	app.GET("/ping", func(*gofr.Context) (any, error) { return "pong", nil })
+
+	cassandraPort, err := strconv.Atoi(app.Config.Get("CASSANDRA_PORT"))
+	if err != nil {
+		app.Logger().Fatalf("invalid CASSANDRA_PORT: %v", err)
+	}
+
+	app.AddCassandra(cassandra.New(cassandra.Config{
+		Hosts:    app.Config.Get("CASSANDRA_HOSTS"),
+		Keyspace: app.Config.Get("CASSANDRA_KEYSPACE"),
+		Port:     cassandraPort,
+		Username: app.Config.Get("CASSANDRA_USERNAME"),
+		Password: app.Config.Get("CASSANDRA_PASSWORD"),
+	}))
+
+	app.Run() // blocks
+}
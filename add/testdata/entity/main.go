@@ -0,0 +1,30 @@
+package main
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/acme/shop/handler"
+	"github.com/acme/shop/migrations"
+	"github.com/acme/shop/service"
+	"github.com/acme/shop/store"
+)
+
+// main starts the shop.
+func main() {
+	app := gofr.New()
+
+	// health of the shop
+	app.GET("/ping", func(*gofr.Context) (any, error) { return "pong", nil })
+
+	app.Migrate(migrations.All())
+
+	orderHandler := handler.NewOrder(service.NewOrder(store.NewOrder()))
+
+	app.POST("/order", orderHandler.Create)
+	app.GET("/order", orderHandler.GetAll)
+	app.GET("/order/{id}", orderHandler.GetByID)
+	app.PUT("/order/{id}", orderHandler.Update)
+	app.DELETE("/order/{id}", orderHandler.Delete)
+
+	app.Run() // blocks
+}
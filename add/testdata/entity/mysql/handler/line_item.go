@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"strconv"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/acme/shop/models"
+)
+
+// LineItemService is the service layer the lineItem handler depends on.
+type LineItemService interface {
+	Create(ctx *gofr.Context, lineItem *models.LineItem) (*models.LineItem, error)
+	GetAll(ctx *gofr.Context) ([]models.LineItem, error)
+	GetByID(ctx *gofr.Context, id int) (*models.LineItem, error)
+	Update(ctx *gofr.Context, lineItem *models.LineItem) (*models.LineItem, error)
+	Delete(ctx *gofr.Context, id int) error
+}
+
+// LineItem serves the lineItem endpoints below /line-item.
+type LineItem struct {
+	service LineItemService
+}
+
+// NewLineItem creates the lineItem handler backed by the given service.
+func NewLineItem(service LineItemService) *LineItem {
+	return &LineItem{service: service}
+}
+
+// Create handles POST /line-item.
+func (h *LineItem) Create(ctx *gofr.Context) (any, error) {
+	var lineItem models.LineItem
+
+	if err := ctx.Bind(&lineItem); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	return h.service.Create(ctx, &lineItem)
+}
+
+// GetAll handles GET /line-item.
+func (h *LineItem) GetAll(ctx *gofr.Context) (any, error) {
+	return h.service.GetAll(ctx)
+}
+
+// GetByID handles GET /line-item/{id}.
+func (h *LineItem) GetByID(ctx *gofr.Context) (any, error) {
+	id, err := parseLineItemID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.service.GetByID(ctx, id)
+}
+
+// Update handles PUT /line-item/{id}.
+func (h *LineItem) Update(ctx *gofr.Context) (any, error) {
+	id, err := parseLineItemID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var lineItem models.LineItem
+
+	if err = ctx.Bind(&lineItem); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	lineItem.ID = id
+
+	return h.service.Update(ctx, &lineItem)
+}
+
+// Delete handles DELETE /line-item/{id}.
+func (h *LineItem) Delete(ctx *gofr.Context) (any, error) {
+	id, err := parseLineItemID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, h.service.Delete(ctx, id)
+}
+
+func parseLineItemID(ctx *gofr.Context) (int, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return 0, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return id, nil
+}
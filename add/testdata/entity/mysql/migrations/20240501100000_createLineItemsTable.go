@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func createLineItemsTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE IF NOT EXISTS line_items (
+	id INT AUTO_INCREMENT PRIMARY KEY,
+	sku VARCHAR(255) NOT NULL,
+	quantity BIGINT NOT NULL,
+	gift BOOLEAN NOT NULL
+)`)
+
+			return err
+		},
+	}
+}
@@ -0,0 +1,9 @@
+package models
+
+// LineItem is stored in the line_items table.
+type LineItem struct {
+	ID       int    `json:"id"`
+	Sku      string `json:"sku"`
+	Quantity int64  `json:"quantity"`
+	Gift     bool   `json:"gift"`
+}
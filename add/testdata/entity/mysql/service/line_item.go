@@ -0,0 +1,51 @@
+package service
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/acme/shop/models"
+)
+
+// LineItemStore is the store layer the lineItem service depends on.
+type LineItemStore interface {
+	Create(ctx *gofr.Context, lineItem *models.LineItem) (*models.LineItem, error)
+	GetAll(ctx *gofr.Context) ([]models.LineItem, error)
+	GetByID(ctx *gofr.Context, id int) (*models.LineItem, error)
+	Update(ctx *gofr.Context, lineItem *models.LineItem) (*models.LineItem, error)
+	Delete(ctx *gofr.Context, id int) error
+}
+
+// LineItem holds the business logic of lineItems, add the validation of lineItems here.
+type LineItem struct {
+	store LineItemStore
+}
+
+// NewLineItem creates the lineItem service backed by the given store.
+func NewLineItem(store LineItemStore) *LineItem {
+	return &LineItem{store: store}
+}
+
+// Create stores a new lineItem.
+func (s *LineItem) Create(ctx *gofr.Context, lineItem *models.LineItem) (*models.LineItem, error) {
+	return s.store.Create(ctx, lineItem)
+}
+
+// GetAll returns all the lineItems.
+func (s *LineItem) GetAll(ctx *gofr.Context) ([]models.LineItem, error) {
+	return s.store.GetAll(ctx)
+}
+
+// GetByID returns the lineItem with the given id.
+func (s *LineItem) GetByID(ctx *gofr.Context, id int) (*models.LineItem, error) {
+	return s.store.GetByID(ctx, id)
+}
+
+// Update replaces the lineItem with the id of the given one.
+func (s *LineItem) Update(ctx *gofr.Context, lineItem *models.LineItem) (*models.LineItem, error) {
+	return s.store.Update(ctx, lineItem)
+}
+
+// Delete removes the lineItem with the given id.
+func (s *LineItem) Delete(ctx *gofr.Context, id int) error {
+	return s.store.Delete(ctx, id)
+}
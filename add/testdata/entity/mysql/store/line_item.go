@@ -0,0 +1,131 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/acme/shop/models"
+)
+
+// the queries of the line_items table.
+const (
+	lineItemInsert     = "INSERT INTO line_items (sku, quantity, gift) VALUES (?, ?, ?)"
+	lineItemSelectAll  = "SELECT id, sku, quantity, gift FROM line_items"
+	lineItemSelectByID = "SELECT id, sku, quantity, gift FROM line_items WHERE id = ?"
+	lineItemUpdate     = "UPDATE line_items SET sku = ?, quantity = ?, gift = ? WHERE id = ?"
+	lineItemDelete     = "DELETE FROM line_items WHERE id = ?"
+)
+
+// LineItem persists lineItems in the line_items table through ctx.SQL.
+type LineItem struct{}
+
+// NewLineItem creates the store of lineItems.
+func NewLineItem() *LineItem {
+	return &LineItem{}
+}
+
+// Create inserts the lineItem and returns it with its generated id.
+func (*LineItem) Create(ctx *gofr.Context, lineItem *models.LineItem) (*models.LineItem, error) {
+	res, err := ctx.SQL.ExecContext(ctx, lineItemInsert, lineItem.Sku, lineItem.Quantity, lineItem.Gift)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	lineItem.ID = int(id)
+
+	return lineItem, nil
+}
+
+// GetAll returns all the lineItems.
+func (*LineItem) GetAll(ctx *gofr.Context) ([]models.LineItem, error) {
+	rows, err := ctx.SQL.QueryContext(ctx, lineItemSelectAll)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	lineItems := []models.LineItem{}
+
+	for rows.Next() {
+		var lineItem models.LineItem
+
+		err = rows.Scan(&lineItem.ID, &lineItem.Sku, &lineItem.Quantity, &lineItem.Gift)
+		if err != nil {
+			return nil, err
+		}
+
+		lineItems = append(lineItems, lineItem)
+	}
+
+	return lineItems, rows.Err()
+}
+
+// GetByID returns the lineItem with the given id.
+func (*LineItem) GetByID(ctx *gofr.Context, id int) (*models.LineItem, error) {
+	var lineItem models.LineItem
+
+	row := ctx.SQL.QueryRowContext(ctx, lineItemSelectByID, id)
+
+	err := row.Scan(&lineItem.ID, &lineItem.Sku, &lineItem.Quantity, &lineItem.Gift)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, http.ErrorEntityNotFound{Name: "id", Value: fmt.Sprint(id)}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &lineItem, nil
+}
+
+// Update replaces the lineItem with the id of the given one.
+func (s *LineItem) Update(ctx *gofr.Context, lineItem *models.LineItem) (*models.LineItem, error) {
+	res, err := ctx.SQL.ExecContext(ctx, lineItemUpdate, lineItem.Sku, lineItem.Quantity, lineItem.Gift, lineItem.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if updated == 0 {
+		// MySQL only counts the rows whose values changed, the id is looked up to tell an unchanged row from a missing one.
+		_, err = s.GetByID(ctx, lineItem.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return lineItem, nil
+}
+
+// Delete removes the lineItem with the given id.
+func (*LineItem) Delete(ctx *gofr.Context, id int) error {
+	res, err := ctx.SQL.ExecContext(ctx, lineItemDelete, id)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return http.ErrorEntityNotFound{Name: "id", Value: fmt.Sprint(id)}
+	}
+
+	return nil
+}
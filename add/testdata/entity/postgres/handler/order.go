@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"strconv"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/acme/shop/models"
+)
+
+// OrderService is the service layer the order handler depends on.
+type OrderService interface {
+	Create(ctx *gofr.Context, order *models.Order) (*models.Order, error)
+	GetAll(ctx *gofr.Context) ([]models.Order, error)
+	GetByID(ctx *gofr.Context, id int) (*models.Order, error)
+	Update(ctx *gofr.Context, order *models.Order) (*models.Order, error)
+	Delete(ctx *gofr.Context, id int) error
+}
+
+// Order serves the order endpoints below /order.
+type Order struct {
+	service OrderService
+}
+
+// NewOrder creates the order handler backed by the given service.
+func NewOrder(service OrderService) *Order {
+	return &Order{service: service}
+}
+
+// Create handles POST /order.
+func (h *Order) Create(ctx *gofr.Context) (any, error) {
+	var order models.Order
+
+	if err := ctx.Bind(&order); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	return h.service.Create(ctx, &order)
+}
+
+// GetAll handles GET /order.
+func (h *Order) GetAll(ctx *gofr.Context) (any, error) {
+	return h.service.GetAll(ctx)
+}
+
+// GetByID handles GET /order/{id}.
+func (h *Order) GetByID(ctx *gofr.Context) (any, error) {
+	id, err := parseOrderID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.service.GetByID(ctx, id)
+}
+
+// Update handles PUT /order/{id}.
+func (h *Order) Update(ctx *gofr.Context) (any, error) {
+	id, err := parseOrderID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var order models.Order
+
+	if err = ctx.Bind(&order); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	order.ID = id
+
+	return h.service.Update(ctx, &order)
+}
+
+// Delete handles DELETE /order/{id}.
+func (h *Order) Delete(ctx *gofr.Context) (any, error) {
+	id, err := parseOrderID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, h.service.Delete(ctx, id)
+}
+
+func parseOrderID(ctx *gofr.Context) (int, error) {
+	id, err := strconv.Atoi(ctx.PathParam("id"))
+	if err != nil {
+		return 0, http.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	return id, nil
+}
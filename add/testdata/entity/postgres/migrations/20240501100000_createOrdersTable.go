@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func createOrdersTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE IF NOT EXISTS orders (
+	id SERIAL PRIMARY KEY,
+	customer VARCHAR(255) NOT NULL,
+	total DOUBLE PRECISION NOT NULL,
+	created_at TIMESTAMP NOT NULL
+)`)
+
+			return err
+		},
+	}
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// Order is stored in the orders table.
+type Order struct {
+	ID        int       `json:"id"`
+	Customer  string    `json:"customer"`
+	Total     float64   `json:"total"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/acme/shop/models"
+)
+
+// OrderStore is the store layer the order service depends on.
+type OrderStore interface {
+	Create(ctx *gofr.Context, order *models.Order) (*models.Order, error)
+	GetAll(ctx *gofr.Context) ([]models.Order, error)
+	GetByID(ctx *gofr.Context, id int) (*models.Order, error)
+	Update(ctx *gofr.Context, order *models.Order) (*models.Order, error)
+	Delete(ctx *gofr.Context, id int) error
+}
+
+// Order holds the business logic of orders, add the validation of orders here.
+type Order struct {
+	store OrderStore
+}
+
+// NewOrder creates the order service backed by the given store.
+func NewOrder(store OrderStore) *Order {
+	return &Order{store: store}
+}
+
+// Create stores a new order.
+func (s *Order) Create(ctx *gofr.Context, order *models.Order) (*models.Order, error) {
+	return s.store.Create(ctx, order)
+}
+
+// GetAll returns all the orders.
+func (s *Order) GetAll(ctx *gofr.Context) ([]models.Order, error) {
+	return s.store.GetAll(ctx)
+}
+
+// GetByID returns the order with the given id.
+func (s *Order) GetByID(ctx *gofr.Context, id int) (*models.Order, error) {
+	return s.store.GetByID(ctx, id)
+}
+
+// Update replaces the order with the id of the given one.
+func (s *Order) Update(ctx *gofr.Context, order *models.Order) (*models.Order, error) {
+	return s.store.Update(ctx, order)
+}
+
+// Delete removes the order with the given id.
+func (s *Order) Delete(ctx *gofr.Context, id int) error {
+	return s.store.Delete(ctx, id)
+}
@@ -0,0 +1,123 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/acme/shop/models"
+)
+
+// the queries of the orders table.
+const (
+	orderInsert = "INSERT INTO orders (customer, total, created_at) VALUES ($1, $2, $3) " +
+		"RETURNING id"
+	orderSelectAll  = "SELECT id, customer, total, created_at FROM orders"
+	orderSelectByID = "SELECT id, customer, total, created_at FROM orders WHERE id = $1"
+	orderUpdate     = "UPDATE orders SET customer = $1, total = $2, created_at = $3 WHERE id = $4"
+	orderDelete     = "DELETE FROM orders WHERE id = $1"
+)
+
+// Order persists orders in the orders table through ctx.SQL.
+type Order struct{}
+
+// NewOrder creates the store of orders.
+func NewOrder() *Order {
+	return &Order{}
+}
+
+// Create inserts the order and returns it with its generated id.
+func (*Order) Create(ctx *gofr.Context, order *models.Order) (*models.Order, error) {
+	row := ctx.SQL.QueryRowContext(ctx, orderInsert, order.Customer, order.Total, order.CreatedAt)
+
+	err := row.Scan(&order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// GetAll returns all the orders.
+func (*Order) GetAll(ctx *gofr.Context) ([]models.Order, error) {
+	rows, err := ctx.SQL.QueryContext(ctx, orderSelectAll)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	orders := []models.Order{}
+
+	for rows.Next() {
+		var order models.Order
+
+		err = rows.Scan(&order.ID, &order.Customer, &order.Total, &order.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, order)
+	}
+
+	return orders, rows.Err()
+}
+
+// GetByID returns the order with the given id.
+func (*Order) GetByID(ctx *gofr.Context, id int) (*models.Order, error) {
+	var order models.Order
+
+	row := ctx.SQL.QueryRowContext(ctx, orderSelectByID, id)
+
+	err := row.Scan(&order.ID, &order.Customer, &order.Total, &order.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, http.ErrorEntityNotFound{Name: "id", Value: fmt.Sprint(id)}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// Update replaces the order with the id of the given one.
+func (*Order) Update(ctx *gofr.Context, order *models.Order) (*models.Order, error) {
+	res, err := ctx.SQL.ExecContext(ctx, orderUpdate, order.Customer, order.Total, order.CreatedAt, order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if updated == 0 {
+		return nil, http.ErrorEntityNotFound{Name: "id", Value: fmt.Sprint(order.ID)}
+	}
+
+	return order, nil
+}
+
+// Delete removes the order with the given id.
+func (*Order) Delete(ctx *gofr.Context, id int) error {
+	res, err := ctx.SQL.ExecContext(ctx, orderDelete, id)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return http.ErrorEntityNotFound{Name: "id", Value: fmt.Sprint(id)}
+	}
+
+	return nil
+}
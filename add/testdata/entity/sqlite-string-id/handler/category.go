@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/acme/shop/models"
+)
+
+// CategoryService is the service layer the category handler depends on.
+type CategoryService interface {
+	Create(ctx *gofr.Context, category *models.Category) (*models.Category, error)
+	GetAll(ctx *gofr.Context) ([]models.Category, error)
+	GetByID(ctx *gofr.Context, id string) (*models.Category, error)
+	Update(ctx *gofr.Context, category *models.Category) (*models.Category, error)
+	Delete(ctx *gofr.Context, id string) error
+}
+
+// Category serves the category endpoints below /category.
+type Category struct {
+	service CategoryService
+}
+
+// NewCategory creates the category handler backed by the given service.
+func NewCategory(service CategoryService) *Category {
+	return &Category{service: service}
+}
+
+// Create handles POST /category.
+func (h *Category) Create(ctx *gofr.Context) (any, error) {
+	var category models.Category
+
+	if err := ctx.Bind(&category); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	return h.service.Create(ctx, &category)
+}
+
+// GetAll handles GET /category.
+func (h *Category) GetAll(ctx *gofr.Context) (any, error) {
+	return h.service.GetAll(ctx)
+}
+
+// GetByID handles GET /category/{id}.
+func (h *Category) GetByID(ctx *gofr.Context) (any, error) {
+	id, err := parseCategoryID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.service.GetByID(ctx, id)
+}
+
+// Update handles PUT /category/{id}.
+func (h *Category) Update(ctx *gofr.Context) (any, error) {
+	id, err := parseCategoryID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var category models.Category
+
+	if err = ctx.Bind(&category); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	category.ID = id
+
+	return h.service.Update(ctx, &category)
+}
+
+// Delete handles DELETE /category/{id}.
+func (h *Category) Delete(ctx *gofr.Context) (any, error) {
+	id, err := parseCategoryID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, h.service.Delete(ctx, id)
+}
+
+func parseCategoryID(ctx *gofr.Context) (string, error) {
+	id := ctx.PathParam("id")
+	if id == "" {
+		return "", http.ErrorMissingParam{Params: []string{"id"}}
+	}
+
+	return id, nil
+}
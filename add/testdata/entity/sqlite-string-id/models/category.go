@@ -0,0 +1,7 @@
+package models
+
+// Category is stored in the categories table.
+type Category struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
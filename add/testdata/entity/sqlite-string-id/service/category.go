@@ -0,0 +1,51 @@
+package service
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/acme/shop/models"
+)
+
+// CategoryStore is the store layer the category service depends on.
+type CategoryStore interface {
+	Create(ctx *gofr.Context, category *models.Category) (*models.Category, error)
+	GetAll(ctx *gofr.Context) ([]models.Category, error)
+	GetByID(ctx *gofr.Context, id string) (*models.Category, error)
+	Update(ctx *gofr.Context, category *models.Category) (*models.Category, error)
+	Delete(ctx *gofr.Context, id string) error
+}
+
+// Category holds the business logic of categories, add the validation of categories here.
+type Category struct {
+	store CategoryStore
+}
+
+// NewCategory creates the category service backed by the given store.
+func NewCategory(store CategoryStore) *Category {
+	return &Category{store: store}
+}
+
+// Create stores a new category.
+func (s *Category) Create(ctx *gofr.Context, category *models.Category) (*models.Category, error) {
+	return s.store.Create(ctx, category)
+}
+
+// GetAll returns all the categories.
+func (s *Category) GetAll(ctx *gofr.Context) ([]models.Category, error) {
+	return s.store.GetAll(ctx)
+}
+
+// GetByID returns the category with the given id.
+func (s *Category) GetByID(ctx *gofr.Context, id string) (*models.Category, error) {
+	return s.store.GetByID(ctx, id)
+}
+
+// Update replaces the category with the id of the given one.
+func (s *Category) Update(ctx *gofr.Context, category *models.Category) (*models.Category, error) {
+	return s.store.Update(ctx, category)
+}
+
+// Delete removes the category with the given id.
+func (s *Category) Delete(ctx *gofr.Context, id string) error {
+	return s.store.Delete(ctx, id)
+}
@@ -0,0 +1,120 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/acme/shop/models"
+)
+
+// the queries of the categories table.
+const (
+	categoryInsert     = "INSERT INTO categories (id, name) VALUES (?, ?)"
+	categorySelectAll  = "SELECT id, name FROM categories"
+	categorySelectByID = "SELECT id, name FROM categories WHERE id = ?"
+	categoryUpdate     = "UPDATE categories SET name = ? WHERE id = ?"
+	categoryDelete     = "DELETE FROM categories WHERE id = ?"
+)
+
+// Category persists categories in the categories table through ctx.SQL.
+type Category struct{}
+
+// NewCategory creates the store of categories.
+func NewCategory() *Category {
+	return &Category{}
+}
+
+// Create inserts the category and returns it.
+func (*Category) Create(ctx *gofr.Context, category *models.Category) (*models.Category, error) {
+	_, err := ctx.SQL.ExecContext(ctx, categoryInsert, category.ID, category.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// GetAll returns all the categories.
+func (*Category) GetAll(ctx *gofr.Context) ([]models.Category, error) {
+	rows, err := ctx.SQL.QueryContext(ctx, categorySelectAll)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	categories := []models.Category{}
+
+	for rows.Next() {
+		var category models.Category
+
+		err = rows.Scan(&category.ID, &category.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		categories = append(categories, category)
+	}
+
+	return categories, rows.Err()
+}
+
+// GetByID returns the category with the given id.
+func (*Category) GetByID(ctx *gofr.Context, id string) (*models.Category, error) {
+	var category models.Category
+
+	row := ctx.SQL.QueryRowContext(ctx, categorySelectByID, id)
+
+	err := row.Scan(&category.ID, &category.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, http.ErrorEntityNotFound{Name: "id", Value: fmt.Sprint(id)}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+// Update replaces the category with the id of the given one.
+func (*Category) Update(ctx *gofr.Context, category *models.Category) (*models.Category, error) {
+	res, err := ctx.SQL.ExecContext(ctx, categoryUpdate, category.Name, category.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if updated == 0 {
+		return nil, http.ErrorEntityNotFound{Name: "id", Value: fmt.Sprint(category.ID)}
+	}
+
+	return category, nil
+}
+
+// Delete removes the category with the given id.
+func (*Category) Delete(ctx *gofr.Context, id string) error {
+	res, err := ctx.SQL.ExecContext(ctx, categoryDelete, id)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return http.ErrorEntityNotFound{Name: "id", Value: fmt.Sprint(id)}
+	}
+
+	return nil
+}
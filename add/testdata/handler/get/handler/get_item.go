@@ -0,0 +1,14 @@
+package handler
+
+import (
+	"gofr.dev/pkg/gofr"
+)
+
+// GetItem handles GET /orders/{id:[0-9]+}/items/{item}.
+func GetItem(ctx *gofr.Context) (any, error) {
+	// replace echoing the request with the logic of the handler.
+	return map[string]any{
+		"id":   ctx.PathParam("id"),
+		"item": ctx.PathParam("item"),
+	}, nil
+}
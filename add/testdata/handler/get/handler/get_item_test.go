@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+)
+
+func TestGetItem(t *testing.T) {
+	c, _ := container.NewMockContainer(t)
+
+	tests := []struct {
+		desc string
+		body string
+		err  error
+	}{
+		{"valid request", `{}`, nil},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/orders/1/items/1", strings.NewReader(tc.body))
+		req.Header.Set("Content-Type", "application/json")
+		req = mux.SetURLVars(req, map[string]string{
+			"id":   "1",
+			"item": "1",
+		})
+
+		ctx := &gofr.Context{Context: context.Background(), Request: gofrHTTP.NewRequest(req), Container: c}
+
+		_, err := GetItem(ctx)
+
+		assert.Equal(t, tc.err, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http"
+)
+
+// UpdateOrderRequest is the body of POST /orders/{id}, add the fields the handler reads.
+type UpdateOrderRequest struct{}
+
+// UpdateOrder handles POST /orders/{id}.
+func UpdateOrder(ctx *gofr.Context) (any, error) {
+	var req UpdateOrderRequest
+
+	if err := ctx.Bind(&req); err != nil {
+		return nil, http.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	// replace echoing the request with the logic of the handler.
+	return map[string]any{
+		"id":      ctx.PathParam("id"),
+		"request": req,
+	}, nil
+}
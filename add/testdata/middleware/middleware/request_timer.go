@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"net/http"
+
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+)
+
+// RequestTimer returns a middleware wrapping every handler of the app.
+func RequestTimer() gofrHTTP.Middleware {
+	return func(inner http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// add the logic running before the handler here, return without calling it to reject the request.
+			inner.ServeHTTP(w, r)
+			// add the logic running after the handler here.
+		})
+	}
+}
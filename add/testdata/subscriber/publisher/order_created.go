@@ -0,0 +1,19 @@
+package publisher
+
+import (
+	"encoding/json"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/acme/shop/subscriber"
+)
+
+// OrderCreated publishes msg on the order-created topic consumed by subscriber.OrderCreated.
+func OrderCreated(ctx *gofr.Context, msg *subscriber.OrderCreatedMessage) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return ctx.GetPublisher().Publish(ctx, subscriber.OrderCreatedTopic, payload)
+}
@@ -0,0 +1,30 @@
+package subscriber
+
+import (
+	"gofr.dev/pkg/gofr"
+)
+
+// OrderCreatedTopic is the topic OrderCreated consumes messages from.
+const OrderCreatedTopic = "order-created"
+
+// OrderCreatedMessage is the payload published on the order-created topic, add its fields.
+type OrderCreatedMessage struct {
+	ID string `json:"id"`
+}
+
+// OrderCreated consumes messages from the order-created topic. Returning an error
+// leaves the message uncommitted so that it is delivered again.
+func OrderCreated(ctx *gofr.Context) error {
+	var msg OrderCreatedMessage
+
+	if err := ctx.Bind(&msg); err != nil {
+		ctx.Logger.Errorf("unable to bind order-created message: %v", err)
+
+		// returning nil commits the message, malformed payloads can never be processed.
+		return nil
+	}
+
+	ctx.Logger.Infof("received order-created message %s", msg.ID)
+
+	return nil
+}
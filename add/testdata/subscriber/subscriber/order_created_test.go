@@ -0,0 +1,33 @@
+package subscriber
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+)
+
+func TestOrderCreated(t *testing.T) {
+	tests := []struct {
+		desc    string
+		payload string
+	}{
+		{"valid message", `{"id":"1"}`},
+		{"malformed message", `{"id":`},
+	}
+
+	c, _ := container.NewMockContainer(t)
+
+	for i, tc := range tests {
+		msg := pubsub.NewMessage(context.Background())
+		msg.Topic = OrderCreatedTopic
+		msg.Value = []byte(tc.payload)
+
+		ctx := &gofr.Context{Context: context.Background(), Request: msg, Container: c}
+
+		assert.NoError(t, OrderCreated(ctx), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"github.com/gorilla/websocket"
+	"gofr.dev/pkg/gofr"
+)
+
+// ChatMessage is the message exchanged on the websocket connections of /chat/{room}, add its fields.
+type ChatMessage struct {
+	Text string `json:"text"`
+}
+
+// Chat serves a websocket connection of /chat/{room}. It reads the messages of the client and replies to
+// each of them until the client closes the connection.
+func Chat(ctx *gofr.Context) (any, error) {
+	for {
+		var msg ChatMessage
+
+		err := ctx.Bind(&msg)
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// replace echoing the message with the logic of the handler.
+		err = ctx.WriteMessageToSocket(ChatMessage{Text: msg.Text})
+		if err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrWebSocket "gofr.dev/pkg/gofr/websocket"
+)
+
+func TestChat(t *testing.T) {
+	c, _ := container.NewMockContainer(t)
+	c.WSManager = gofrWebSocket.New()
+
+	errs := make(chan error, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		wsConn := &gofrWebSocket.Connection{Conn: conn}
+		c.WSManager.AddWebsocketConnection(t.Name(), wsConn)
+
+		ctx := &gofr.Context{
+			Context:   context.WithValue(r.Context(), gofrWebSocket.WSConnectionKey, t.Name()),
+			Request:   wsConn,
+			Container: c,
+		}
+
+		_, err = Chat(ctx)
+		errs <- err
+	}))
+	defer server.Close()
+
+	client, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/chat/1", nil)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	require.NoError(t, client.WriteJSON(ChatMessage{Text: "hello"}))
+
+	var reply ChatMessage
+
+	require.NoError(t, client.ReadJSON(&reply))
+	assert.Equal(t, ChatMessage{Text: "hello"}, reply)
+
+	require.NoError(t, client.WriteMessage(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")))
+
+	assert.NoError(t, <-errs)
+}
@@ -0,0 +1,134 @@
+package add
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+
+	"gofr.dev/cli/gofr/modcache"
+)
+
+const (
+	defaultWebSocketPath = "/ws"
+	webSocketModule      = "github.com/gorilla/websocket"
+)
+
+var errWebSocketCMD = errors.New("command line applications can not serve websocket connections")
+
+// WebSocket generates a handler serving websocket connections, with a test connecting to it, in the handler
+// package and registers it with app.WebSocket in main.go, e.g.
+//
+//	gofr add websocket -name=Chat -path=/chat
+//
+// The path defaults to /ws. Existing files are only overwritten with -force.
+func WebSocket(ctx *gofr.Context) (any, error) {
+	p, err := LoadProject(".")
+	if err != nil {
+		return nil, err
+	}
+
+	routePath := ctx.Param("path")
+	if routePath == "" {
+		routePath = defaultWebSocketPath
+	}
+
+	h, err := newHandlerData(ctx.Param("name"), "GET", routePath)
+	if err != nil {
+		return nil, err
+	}
+
+	main, err := readMain(".")
+	if err != nil {
+		return nil, err
+	}
+
+	err = registerWebSocket(main, p, h)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := webSocketFiles(h)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := []File{main.file()}
+	msg := fmt.Sprintf("Successfully added websocket handler %s for %s", h.Name, h.Path)
+
+	// the handler imports gorilla/websocket, which GoFr only requires indirectly.
+	if version := modcache.Latest(webSocketModule); version != "" {
+		mod, modErr := requireModule(".", webSocketModule, version)
+		if modErr != nil {
+			return nil, modErr
+		}
+
+		if mod != nil {
+			updated = append(updated, *mod)
+		}
+	} else {
+		msg += ", run go mod tidy to require " + webSocketModule
+	}
+
+	err = writeChanges(".", files, updated, ctx.Param("force") == "true")
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func webSocketFiles(h *handlerData) ([]File, error) {
+	file := snake(h.Name)
+
+	var files []File
+
+	for _, name := range []string{"websocket.go", "websocket_test.go"} {
+		content, err := templates.ReadFile(path.Join("templates", "websocket", name+".tmpl"))
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, File{
+			Path:    path.Join(handlerDir, file+strings.TrimPrefix(name, "websocket")),
+			Content: content,
+		})
+	}
+
+	files, err := renderFiles(h, "{{", "}}", files)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatGo(files)
+}
+
+// registerWebSocket adds the websocket route of the handler to main, unless main registers the route already.
+func registerWebSocket(main *mainFile, p *Project, h *handlerData) error {
+	if p.CMD {
+		return errWebSocketCMD
+	}
+
+	app, err := main.app()
+	if err != nil {
+		return err
+	}
+
+	exists, err := main.registered("WebSocket", h.Path)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return fmt.Errorf("%w: WebSocket %s", errRouteExists, h.Path)
+	}
+
+	err = main.addImport("", p.Module+"/"+handlerDir)
+	if err != nil {
+		return err
+	}
+
+	return main.insert(fmt.Sprintf("%s.WebSocket(%q, %s.%s)", app, h.Path, handlerDir, h.Name))
+}
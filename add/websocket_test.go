@@ -0,0 +1,30 @@
+package add
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddWebSocket(t *testing.T) {
+	h, err := newHandlerData("Chat", "GET", "/chat/{room}")
+	require.NoError(t, err)
+
+	files, err := webSocketFiles(h)
+	require.NoError(t, err)
+
+	for _, f := range files {
+		assertGolden(t, filepath.Join("testdata", "websocket", f.Path), f.Content)
+	}
+
+	main := &mainFile{src: []byte(testMain)}
+
+	require.NoError(t, registerWebSocket(main, testProject(t, ""), h))
+
+	assertGolden(t, filepath.Join("testdata", "websocket", "main.go"), main.src)
+
+	err = registerWebSocket(main, testProject(t, ""), h)
+
+	require.ErrorIs(t, err, errRouteExists)
+}
@@ -0,0 +1,150 @@
+package bootstrap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// manifestFile declares the variables and the skipped files of a user defined template, it is not rendered.
+const manifestFile = "gofr-template.json"
+
+var (
+	errTemplateConflict = errors.New("-from can not be used together with -template or -layout")
+	errNotADirectory    = errors.New("-from has to be a directory")
+	errMissingVars      = errors.New("the template requires variables, set them with -var key=value")
+	errInvalidVar       = errors.New("invalid -var, expected key=value")
+)
+
+// manifest describes a user defined template.
+type manifest struct {
+	// Required lists the variables which have to be given with -var.
+	Required []string `json:"required"`
+	// Skip lists path.Match patterns, relative to the template root, of files and directories which
+	// are not rendered. A pattern matching a directory skips everything below it.
+	Skip []string `json:"skip"`
+}
+
+// renderUserTemplate renders the directory tree rooted at dir, in the same way as the built-in
+// templates are rendered. The template reads the -var values from .Vars, e.g. {{ .Vars.team }}.
+func renderUserTemplate(dir string, data *modInfo) ([]file, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%w: %s", errNotADirectory, dir)
+	}
+
+	fsys := os.DirFS(dir)
+
+	m, err := readManifest(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+
+	for _, v := range m.Required {
+		if _, ok := data.Vars[v]; !ok {
+			missing = append(missing, v)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", errMissingVars, strings.Join(missing, ", "))
+	}
+
+	files, err := renderTree(fsys, ".", data, m.skips)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].path < files[j].path })
+
+	return files, nil
+}
+
+// readManifest reads the manifest at the root of the template, a template without one requires no variables.
+func readManifest(fsys fs.FS) (*manifest, error) {
+	var m manifest
+
+	data, err := fs.ReadFile(fsys, manifestFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &m, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(data, &m)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", manifestFile, err)
+	}
+
+	for _, pattern := range m.Skip {
+		if _, err = path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("reading %s: skip pattern %q: %w", manifestFile, pattern, err)
+		}
+	}
+
+	return &m, nil
+}
+
+// skips reports whether the file or directory at rel is left out of the project. The manifest and
+// the version control directory of the template are never rendered.
+func (m *manifest) skips(rel string) bool {
+	if rel == manifestFile || rel == ".git" {
+		return true
+	}
+
+	for _, pattern := range m.Skip {
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// templateVars collects the -var key=value arguments. They are read from the command line arguments
+// rather than the request params, as a flag can be given more than once and its value contains a '='.
+func templateVars(args []string) (map[string]string, error) {
+	vars := make(map[string]string)
+
+	for i := 0; i < len(args); i++ {
+		if !strings.HasPrefix(args[i], "-") {
+			continue
+		}
+
+		arg := strings.TrimPrefix(strings.TrimPrefix(args[i], "-"), "-")
+
+		var kv string
+
+		switch {
+		case arg == "var" && i+1 < len(args):
+			i++
+			kv = args[i]
+		case strings.HasPrefix(arg, "var="):
+			kv = strings.TrimPrefix(arg, "var=")
+		default:
+			continue
+		}
+
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%w: %q", errInvalidVar, kv)
+		}
+
+		vars[key] = value
+	}
+
+	return vars, nil
+}
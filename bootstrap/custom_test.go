@@ -0,0 +1,82 @@
+package bootstrap
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTemplate(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), dirMode))
+		require.NoError(t, os.WriteFile(p, []byte(content), fileMode))
+	}
+
+	return dir
+}
+
+func Test_renderUserTemplate(t *testing.T) {
+	dir := writeTemplate(t, map[string]string{
+		manifestFile:            `{"required": ["team"], "skip": ["docs", "*.bak"]}`,
+		"go.mod.tmpl":           "module {{ .Module }}\n",
+		"main.go":               "package main\n\n// owned by {{ .Vars.team }}\nfunc main() {}\n",
+		"{{ .Name }}/README.md": "# {{ pascal .Name }}\n",
+		"docs/design.md":        "draft",
+		"main.go.bak":           "backup",
+	})
+
+	files, err := renderUserTemplate(dir, &modInfo{Module: "github.com/acme/orders", Name: "orders",
+		Vars: map[string]string{"team": "payments"}})
+	require.NoError(t, err)
+
+	rendered := make(map[string]string)
+	for _, f := range files {
+		rendered[f.path] = string(f.content)
+	}
+
+	assert.Equal(t, map[string]string{
+		"go.mod":           "module github.com/acme/orders\n",
+		"main.go":          "package main\n\n// owned by payments\nfunc main() {}\n",
+		"orders/README.md": "# Orders\n",
+	}, rendered)
+}
+
+func Test_renderUserTemplate_Errors(t *testing.T) {
+	tests := []struct {
+		desc  string
+		files map[string]string
+		err   error
+	}{
+		{"missing required variable", map[string]string{manifestFile: `{"required": ["team", "owner"]}`}, errMissingVars},
+		{"unknown variable", map[string]string{"main.go": "// {{ .Vars.team }}"}, nil},
+	}
+
+	for i, tc := range tests {
+		_, err := renderUserTemplate(writeTemplate(t, tc.files), &modInfo{Vars: map[string]string{"owner": "me"}})
+
+		require.Error(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		if tc.err != nil {
+			require.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.desc)
+		}
+	}
+}
+
+func Test_templateVars(t *testing.T) {
+	vars, err := templateVars([]string{"init", "-name=orders", "-var", "team=payments", "--var=dsn=a=b", "-varx=1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"team": "payments", "dsn": "a=b"}, vars)
+
+	_, err = templateVars([]string{"-var", "team"})
+	require.ErrorIs(t, err, errInvalidVar)
+}
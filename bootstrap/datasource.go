@@ -0,0 +1,85 @@
+package bootstrap
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"gofr.dev/cli/gofr/migration"
+)
+
+const (
+	migrationsDir    = "migrations"
+	initialMigration = "initial"
+	mongoModule      = "gofr.dev/pkg/gofr/datasource/mongo"
+)
+
+var (
+	errUnknownDatasource = errors.New("unknown datasource, supported datasources are postgres, mysql, sqlite, redis and mongo")
+	errMultipleSQL       = errors.New("only one SQL datasource can be configured")
+)
+
+// datasources are the datasources selected with the comma separated -db option.
+type datasources struct {
+	// SQL is the dialect of the SQL datasource, one of mysql, postgres or sqlite.
+	SQL   string
+	Redis bool
+	Mongo bool
+}
+
+func parseDatasources(value string) (datasources, error) {
+	var ds datasources
+
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		switch name {
+		case "":
+		case "postgres", "mysql", "sqlite":
+			if ds.SQL != "" && ds.SQL != name {
+				return ds, fmt.Errorf("%w, got %s and %s", errMultipleSQL, ds.SQL, name)
+			}
+
+			ds.SQL = name
+		case "redis":
+			ds.Redis = true
+		case "mongo":
+			ds.Mongo = true
+		default:
+			return ds, fmt.Errorf("%w: %q", errUnknownDatasource, name)
+		}
+	}
+
+	return ds, nil
+}
+
+// MongoModule is the module of the mongo datasource, for the templates requiring it.
+func (datasources) MongoModule() string {
+	return mongoModule
+}
+
+// Migrations reports whether the project needs a migrations package, which is
+// the case as soon as it uses any datasource.
+func (ds datasources) Migrations() bool {
+	return ds.SQL != "" || ds.Redis || ds.Mongo
+}
+
+// migrationFiles returns the migrations package with its initial migration, registered in all.go.
+func migrationFiles(createdAt time.Time) ([]file, error) {
+	name, content, err := migration.File(initialMigration, createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	all, err := migration.AllFile(map[string]string{migration.Version(createdAt): initialMigration})
+	if err != nil {
+		return nil, err
+	}
+
+	return []file{
+		{path: path.Join(migrationsDir, name), content: content},
+		{path: path.Join(migrationsDir, "all.go"), content: all},
+	}, nil
+}
@@ -0,0 +1,98 @@
+package bootstrap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseDatasources(t *testing.T) {
+	tests := []struct {
+		value string
+		want  datasources
+		err   error
+	}{
+		{"", datasources{}, nil},
+		{"postgres", datasources{SQL: "postgres"}, nil},
+		{"MySQL, redis,mongo", datasources{SQL: "mysql", Redis: true, Mongo: true}, nil},
+		{"sqlite,sqlite", datasources{SQL: "sqlite"}, nil},
+		{"mysql,postgres", datasources{SQL: "mysql"}, errMultipleSQL},
+		{"oracle", datasources{}, errUnknownDatasource},
+	}
+
+	for i, tc := range tests {
+		ds, err := parseDatasources(tc.value)
+
+		require.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.value)
+		assert.Equal(t, tc.want, ds, "TEST[%d], Failed.\n%s", i, tc.value)
+	}
+}
+
+func Test_renderProject_Datasources(t *testing.T) {
+	files, err := renderProject("rest", "", &modInfo{Module: "github.com/acme/order-service", Name: "order-service",
+		Template: "rest", datasources: datasources{SQL: "postgres", Redis: true}})
+	require.NoError(t, err)
+
+	rendered := make(map[string]string)
+	for _, f := range files {
+		rendered[f.path] = string(f.content)
+	}
+
+	assert.Contains(t, rendered["configs/.env"], "DB_DIALECT=postgres\n")
+	assert.Contains(t, rendered["configs/.env"], "DB_NAME=order_service\n")
+	assert.Contains(t, rendered["configs/.env"], "REDIS_HOST=localhost\n")
+	assert.NotContains(t, rendered["configs/.env"], "MONGO_URI")
+	assert.Contains(t, rendered["main.go"], `"github.com/acme/order-service/migrations"`)
+	assert.Contains(t, rendered["main.go"], "app.Migrate(migrations.All())")
+}
+
+func Test_migrationFiles(t *testing.T) {
+	files, err := migrationFiles(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	assert.Equal(t, "migrations/20240102150405_initial.go", files[0].path)
+	assert.Contains(t, string(files[0].content), "func initial() migration.Migrate")
+	assert.Equal(t, "migrations/all.go", files[1].path)
+	assert.Contains(t, string(files[1].content), "20240102150405: initial(),")
+}
+
+func Test_renderProject_Mongo(t *testing.T) {
+	tests := []struct {
+		mongoVersion string
+		goMod        string
+	}{
+		{"v0.2.0", "require (\n\tgofr.dev v1.28.0\n\tgofr.dev/pkg/gofr/datasource/mongo v0.2.0\n)\n"},
+		// without a version in the module cache go mod tidy adds the requirement.
+		{"", "require gofr.dev v1.28.0\n"},
+	}
+
+	for i, tc := range tests {
+		files, err := renderProject("basic", "", &modInfo{Module: "github.com/acme/catalog", Name: "catalog", GofrVersion: "v1.28.0",
+			GoVersion: "1.22", MongoVersion: tc.mongoVersion, Template: "basic", datasources: datasources{Mongo: true}})
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.mongoVersion)
+
+		rendered := make(map[string]string)
+		for _, f := range files {
+			rendered[f.path] = string(f.content)
+		}
+
+		assert.Equal(t, "module github.com/acme/catalog\n\ngo 1.22\n\n"+tc.goMod, rendered["go.mod"],
+			"TEST[%d], Failed.\n%s", i, tc.mongoVersion)
+		assert.Contains(t, rendered["main.go"], "import (\n\t\"gofr.dev/pkg/gofr\"\n\t\"gofr.dev/pkg/gofr/datasource/mongo\"\n\n"+
+			"\t\"github.com/acme/catalog/migrations\"\n)\n", "TEST[%d], Failed.\n%s", i, tc.mongoVersion)
+	}
+}
+
+func Test_renderProject_BasicWithoutDatasources(t *testing.T) {
+	files, err := renderProject("basic", "", &modInfo{Module: "github.com/acme/hello", Name: "hello", Template: "basic"})
+	require.NoError(t, err)
+
+	for _, f := range files {
+		if f.path == "main.go" {
+			assert.Contains(t, string(f.content), "import (\n\t\"gofr.dev/pkg/gofr\"\n)\n")
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package bootstrap
+
+import (
+	"fmt"
+	"go/format"
+	"path"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"gofr.dev/cli/gofr/wrap"
+)
+
+// descriptorRowLength is the number of bytes per line of the raw descriptor, like protoc-gen-go prints it.
+const descriptorRowLength = 16
+
+// protoDescriptor returns the serialized descriptor of the proto file of a gRPC project, as the rows of a Go byte
+// slice literal like the ones of protoc-gen-go. It describes the service and messages of {{.Name}}.proto.
+func protoDescriptor(m *modInfo) (string, error) {
+	field := func(name string) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(1),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			JsonName: proto.String(name),
+		}
+	}
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name: proto.String(m.Name + ".proto"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("HelloRequest"), Field: []*descriptorpb.FieldDescriptorProto{field("name")}},
+			{Name: proto.String("HelloResponse"), Field: []*descriptorpb.FieldDescriptorProto{field("message")}},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String(pascalCase(m.Name) + "Service"),
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("SayHello"),
+				InputType:  proto.String(".HelloRequest"),
+				OutputType: proto.String(".HelloResponse"),
+				// protoc keeps the empty options of the {} body of the rpc.
+				Options: &descriptorpb.MethodOptions{},
+			}},
+		}},
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String(m.ProtoPackage())},
+		Syntax:  proto.String("proto3"),
+	}
+
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(file)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	for i, c := range raw {
+		switch {
+		case i%descriptorRowLength == 0:
+			b.WriteString("\t")
+		default:
+			b.WriteString(" ")
+		}
+
+		fmt.Fprintf(&b, "0x%02x,", c)
+
+		if i%descriptorRowLength == descriptorRowLength-1 && i != len(raw)-1 {
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String(), nil
+}
+
+// protoIdent returns the name of the proto file like protoc-gen-go uses it in identifiers, e.g. order_service for
+// order-service.proto.
+func protoIdent(name string) string {
+	ident := strings.Map(func(r rune) rune {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return '_'
+		}
+
+		return r
+	}, name)
+
+	if ident != "" && unicode.IsDigit([]rune(ident)[0]) {
+		return "_" + ident
+	}
+
+	return ident
+}
+
+// unexport lowers the first letter of an identifier, like protoc-gen-go-grpc names the client of a service.
+func unexport(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// grpcWrappers renders the files gofr wrap grpc server generates from the proto file of a gRPC project, next to
+// the code of protoc, so that the project builds without running go generate first.
+func grpcWrappers(info *modInfo, files []file) ([]file, error) {
+	protoFile := path.Join(serverDir, info.Name+".proto")
+
+	for _, f := range files {
+		if f.path != protoFile {
+			continue
+		}
+
+		wrappers, err := wrap.ServerWrappers(path.Base(protoFile), f.content)
+		if err != nil {
+			return nil, err
+		}
+
+		generated := make([]file, 0, len(wrappers))
+
+		for name, content := range wrappers {
+			formatted, formatErr := format.Source(content)
+			if formatErr != nil {
+				return nil, fmt.Errorf("formatting %s: %w", name, formatErr)
+			}
+
+			generated = append(generated, file{path: path.Join(serverDir, name), content: formatted})
+		}
+
+		return generated, nil
+	}
+
+	return nil, nil
+}
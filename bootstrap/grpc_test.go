@@ -0,0 +1,53 @@
+package bootstrap
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test_renderProject_Protoc compares the protoc output of the grpc template with the one of protoc-gen-go v1.34.2
+// and protoc-gen-go-grpc v1.5.1 for server/orders.proto.
+func Test_renderProject_Protoc(t *testing.T) {
+	files, err := renderProject("grpc", "", &modInfo{Module: "github.com/acme/orders", Name: "orders",
+		GofrVersion: "v1.28.0", GoVersion: "1.22", Template: "grpc"})
+	require.NoError(t, err)
+
+	for _, f := range files {
+		if !strings.HasSuffix(f.path, ".pb.go") {
+			continue
+		}
+
+		golden := filepath.Join("testdata", "init-grpc", f.path)
+
+		if *update {
+			require.NoError(t, os.MkdirAll(filepath.Dir(golden), dirMode))
+			require.NoError(t, os.WriteFile(golden, f.content, fileMode))
+		}
+
+		want, err := os.ReadFile(golden)
+		require.NoError(t, err)
+
+		assert.Equal(t, string(want), string(f.content), golden)
+	}
+}
+
+func Test_protoIdent(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"orders", "orders"},
+		{"order-service", "order_service"},
+		{"order.v1", "order_v1"},
+		{"1orders", "_1orders"},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.want, protoIdent(tc.name), "TEST[%d], Failed.\n%s", i, tc.name)
+	}
+}
@@ -1,78 +1,378 @@
 package bootstrap
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"os"
-	"text/template"
+	"path"
+	"regexp"
+	"strings"
+	"time"
 
 	"gofr.dev/pkg/gofr"
+
+	"gofr.dev/cli/gofr/add"
+	"gofr.dev/cli/gofr/envfile"
+	"gofr.dev/cli/gofr/modcache"
 )
 
 const (
-	modContent = `module {{ .Module }}
-
-go 1.22.4
-
-require gofr.dev v{{ .GofrVersion }}
-`
-	mainContent = `package main
+	fileMode = 0644
+	dirMode  = 0755
 
-import (
-	"gofr.dev/pkg/gofr"
+	envFile = "configs/.env"
 )
 
-func main() {
-	app := gofr.New()
-
-	app.GET("/hello", func(ctx *gofr.Context) (interface{}, error) {
-		return "Hello World!", nil
-	})
+var majorVersion = regexp.MustCompile(`^v[0-9]+$`)
 
-	app.Run()
-}
-`
-	fileMode = 0644
-)
+var errMissingOptions = errors.New("missing required options")
 
 type modInfo struct {
 	Module      string
+	Name        string
 	GofrVersion string
+	// MongoVersion is the version of the module of the mongo datasource, which is separate from gofr.dev. It is
+	// empty when the project does not use mongo or no version is in the module cache, go mod tidy then adds it.
+	MongoVersion string
+	GoVersion    string
+	Template     string
+	// Vars holds the -var values for user defined templates.
+	Vars map[string]string
+	// Workspace is set when the project is initialized in a go.work workspace.
+	Workspace *workspace
+
+	datasources
+}
+
+// UsesShared reports whether the project has packages which are moved into the shared module of its workspace.
+func (m *modInfo) UsesShared() bool {
+	return m.Workspace != nil && (m.Migrations() || m.Template == grpcTemplate)
+}
+
+// SharedPackage is the directory of the packages of the project in the shared module of its workspace.
+func (m *modInfo) SharedPackage() string {
+	return strings.ToLower(pascalCase(m.Name))
+}
+
+// MigrationsPackage returns the import path of the migrations package of the project.
+func (m *modInfo) MigrationsPackage() string {
+	if m.UsesShared() {
+		return m.Workspace.Shared + "/" + m.SharedPackage() + "/" + migrationsDir
+	}
+
+	return m.Module + "/" + migrationsDir
+}
+
+// ProtoPackage returns the import path of the package holding the proto file of a gRPC project.
+func (m *modInfo) ProtoPackage() string {
+	if m.UsesShared() {
+		return m.Workspace.Shared + "/" + protoDir + "/" + m.SharedPackage()
+	}
+
+	return m.Module + "/" + serverDir
+}
+
+// ProtoDir returns the directory of the proto file of a gRPC project, relative to the project.
+func (m *modInfo) ProtoDir() string {
+	if m.UsesShared() {
+		return path.Join(m.Workspace.SharedDir, protoDir, m.SharedPackage())
+	}
+
+	return serverDir
+}
+
+// options are the init options, given as flags or answered in the interactive wizard.
+type options struct {
+	module      string
+	gofrVersion string
+	archetype   string
+	layout      string
+	from        string
+	vars        map[string]string
+	db          string
+	workspace   bool
+	docker      bool
+	k8s         bool
+	tests       bool
+	force       bool
 }
 
+// Create initializes a new GoFr project in a directory named after the last element of the module path.
+// The -template option selects the project archetype (rest, grpc, pubsub, cron or cmd), a hello world service
+// by default, and -layout=layered splits the basic and rest templates into handler, service and store packages.
+//
+// -db configures datasources and wires their migrations, -gofr pins the gofr.dev version instead of the newest
+// locally available one, -docker and -k8s add the deployment files and -tests=false leaves out the tests.
+//
+// Inside a go.work workspace, or with -workspace which creates one, the project is added to go.work and its
+// migration and proto packages are created in the shared module of the workspace, see workspace.
+//
+// The -from option renders a user defined template from a local directory instead of the built-in ones,
+// with the values of the -var key=value options available to it. See renderUserTemplate.
+//
+// Without -name, init prompts for the options when it runs in a terminal, or with -interactive.
+// Existing files are never overwritten unless -force is given, and everything written is rolled
+// back when init fails.
 func Create(ctx *gofr.Context) (interface{}, error) {
-	name := ctx.Param("name")
-	gofrVersion := ctx.Param("gofr")
+	opts := options{
+		module:      ctx.Param("name"),
+		gofrVersion: ctx.Param("gofr"),
+		archetype:   ctx.Param("template"),
+		layout:      ctx.Param("layout"),
+		from:        ctx.Param("from"),
+		db:          ctx.Param("db"),
+		workspace:   ctx.Param("workspace") == "true",
+		docker:      ctx.Param("docker") == "true",
+		k8s:         ctx.Param("k8s") == "true",
+		tests:       ctx.Param("tests") != "false",
+		force:       ctx.Param("force") == "true",
+	}
+
+	vars, err := templateVars(os.Args[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	opts.vars = vars
 
-	if gofrVersion == "" {
-		gofrVersion = "1.17.0"
+	if opts.module == "" {
+		if ctx.Param("interactive") != "true" && !isTerminal(os.Stdin) {
+			return nil, missingOptions(ctx)
+		}
+
+		err = newWizard(os.Stdin, os.Stdout).run(&opts, askedOptions(ctx))
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	modFile, err := os.OpenFile("go.mod", os.O_CREATE|os.O_WRONLY, fileMode)
+	files, err := generate(&opts)
 	if err != nil {
 		return nil, err
 	}
 
-	t, err := template.New("go-mod").Parse(modContent)
+	dir := projectName(opts.module)
+	w := newProjectWriter(dir, opts.force)
+
+	err = w.check(files)
 	if err != nil {
 		return nil, err
 	}
 
-	err = t.Execute(modFile, modInfo{Module: name, GofrVersion: gofrVersion})
+	for _, f := range files {
+		err = w.write(f)
+		if err != nil {
+			return nil, rollback(w, err)
+		}
+	}
+
+	if opts.docker {
+		fmt.Printf("Note: Please do cd %s && go mod tidy to sync the dependencies of your project before building its image\n", dir)
+	} else {
+		fmt.Printf("Note: Please do cd %s && go mod tidy to sync the dependencies of your project\n", dir)
+	}
+
+	return "Successfully initialized project " + opts.module, nil
+}
+
+// missingOptions returns the error listing the required options which were not given, init prompts for them
+// when it runs in a terminal.
+func missingOptions(ctx *gofr.Context) error {
+	var missing []string
+
+	for _, name := range []string{"name"} {
+		if ctx.Param(name) == "" {
+			missing = append(missing, "-"+name)
+		}
+	}
+
+	return fmt.Errorf("%w: %s, provide them or run gofr init in a terminal to be prompted for them",
+		errMissingOptions, strings.Join(missing, ", "))
+}
+
+// askedOptions returns the options the wizard prompts for, which are the ones not given as flags.
+func askedOptions(ctx *gofr.Context) map[string]bool {
+	asked := make(map[string]bool)
+
+	for _, name := range []string{"name", "gofr", "template", "layout", "db", "docker", "k8s", "tests"} {
+		asked[name] = ctx.Param(name) == ""
+	}
+
+	// a user defined template replaces the built-in ones.
+	asked["template"] = asked["template"] && ctx.Param("from") == ""
+	asked["layout"] = asked["layout"] && ctx.Param("from") == ""
+
+	return asked
+}
+
+// generate renders all the files of the project.
+func generate(opts *options) ([]file, error) {
+	ds, err := parseDatasources(opts.db)
 	if err != nil {
 		return nil, err
 	}
 
-	fmt.Println("Note: Please do go mod tidy to sync the dependencies of your project")
+	if opts.from != "" && (opts.archetype != "" || opts.layout != "") {
+		return nil, errTemplateConflict
+	}
+
+	if opts.from == "" && opts.archetype == "" {
+		opts.archetype = basicTemplate
+	}
 
-	mainFile, err := os.OpenFile("main.go", os.O_CREATE|os.O_WRONLY, fileMode)
+	gofrVersion, err := resolveGofrVersion(opts.gofrVersion)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = mainFile.WriteString(mainContent)
+	ws, err := openWorkspace(projectName(opts.module), opts.module, opts.workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &modInfo{
+		Module:      opts.module,
+		Name:        projectName(opts.module),
+		GofrVersion: gofrVersion,
+		GoVersion:   resolveGoVersion(),
+		Template:    opts.archetype,
+		Vars:        opts.vars,
+		Workspace:   ws,
+		datasources: ds,
+	}
+
+	if ds.Mongo {
+		info.MongoVersion = modcache.Latest(mongoModule)
+	}
+
+	files, err := renderFrom(opts, info)
 	if err != nil {
 		return nil, err
 	}
 
-	return "Successfully initialized project " + name, nil
+	if !opts.tests {
+		files = withoutTests(files)
+	}
+
+	if ds.Migrations() {
+		var migrations []file
+
+		migrations, err = migrationFiles(time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, migrations...)
+	}
+
+	if opts.docker || opts.k8s {
+		var deployment []file
+
+		deployment, err = deploymentFiles(info, files, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, deployment...)
+	}
+
+	if ws != nil {
+		return ws.files(info, files)
+	}
+
+	return files, nil
+}
+
+// renderFrom renders the user defined template given with -from, or the built-in archetype.
+func renderFrom(opts *options, info *modInfo) ([]file, error) {
+	if opts.from != "" {
+		return renderUserTemplate(opts.from, info)
+	}
+
+	return renderProject(opts.archetype, opts.layout, info)
+}
+
+func withoutTests(files []file) []file {
+	filtered := files[:0]
+
+	for _, f := range files {
+		if !strings.HasSuffix(f.path, "_test.go") {
+			filtered = append(filtered, f)
+		}
+	}
+
+	return filtered
+}
+
+// deploymentFiles renders the docker and kubernetes files of the project being initialized from its rendered configs.
+func deploymentFiles(info *modInfo, files []file, opts *options) ([]file, error) {
+	p := &add.Project{
+		Module:    info.Module,
+		Name:      info.Name,
+		GoVersion: info.GoVersion,
+		Env:       &envfile.Env{},
+	}
+
+	for _, f := range files {
+		if f.path == "main.go" {
+			p.CMD = bytes.Contains(f.content, []byte("gofr.NewCMD()"))
+		}
+
+		if f.path == envFile {
+			env, err := envfile.Parse(bytes.NewReader(f.content))
+			if err != nil {
+				return nil, err
+			}
+
+			p.Env = env
+		}
+	}
+
+	var generated []add.File
+
+	if opts.docker {
+		docker, err := add.DockerFiles(p)
+		if err != nil {
+			return nil, err
+		}
+
+		generated = append(generated, docker...)
+	}
+
+	if opts.k8s {
+		k8s, err := add.K8sFiles(p, "", false)
+		if err != nil {
+			return nil, err
+		}
+
+		generated = append(generated, k8s...)
+	}
+
+	converted := make([]file, 0, len(generated))
+	for _, f := range generated {
+		converted = append(converted, file{path: f.Path, content: f.Content})
+	}
+
+	return converted, nil
+}
+
+// projectName returns the last element of the module path, skipping a major version suffix like /v2.
+func projectName(module string) string {
+	name := path.Base(module)
+
+	if majorVersion.MatchString(name) && path.Dir(module) != "." {
+		return path.Base(path.Dir(module))
+	}
+
+	return name
+}
+
+// rollback undoes the changes of a failed init and returns the error which caused it.
+func rollback(w *projectWriter, err error) error {
+	if rbErr := w.rollback(); rbErr != nil {
+		return fmt.Errorf("%w, rolling back the project failed: %w", err, rbErr)
+	}
+
+	return err
 }
@@ -0,0 +1,44 @@
+package bootstrap
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:gochecknoglobals // test flag to regenerate the golden files.
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func Test_deploymentFiles_Docker(t *testing.T) {
+	info := &modInfo{Module: "github.com/acme/orders", Name: "orders", GofrVersion: "v1.28.0", GoVersion: "1.22",
+		Template: "rest", datasources: datasources{SQL: "postgres", Redis: true}}
+
+	files, err := renderProject("rest", "", info)
+	require.NoError(t, err)
+
+	// a new project has no go.sum until go mod tidy runs, its Dockerfile has to build without one.
+	for _, f := range files {
+		assert.NotEqual(t, "go.sum", f.path)
+	}
+
+	deployment, err := deploymentFiles(info, files, &options{docker: true})
+	require.NoError(t, err)
+
+	for _, f := range deployment {
+		golden := filepath.Join("testdata", "init-docker", f.path)
+
+		if *update {
+			require.NoError(t, os.MkdirAll(filepath.Dir(golden), dirMode))
+			require.NoError(t, os.WriteFile(golden, f.content, fileMode))
+		}
+
+		want, err := os.ReadFile(golden)
+		require.NoError(t, err)
+
+		assert.Equal(t, string(want), string(f.content), golden)
+	}
+}
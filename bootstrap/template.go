@@ -0,0 +1,247 @@
+package bootstrap
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const (
+	templateDir    = "templates"
+	commonTemplate = "common"
+	layoutsDir     = "layouts"
+	layeredLayout  = "layered"
+	basicTemplate  = "basic"
+	grpcTemplate   = "grpc"
+	cmdTemplate    = "cmd"
+	templateSuffix = ".tmpl"
+	partialsFile   = "partials.tmpl"
+)
+
+var (
+	errUnknownTemplate = errors.New("unknown project template")
+	errUnknownLayout   = errors.New("unknown project layout, the only layout is layered")
+	errLayoutTemplate  = errors.New("the layered layout is only available for the basic and rest templates")
+)
+
+// templates holds the project archetypes. Every directory below templates is an archetype, except
+// common which is rendered for all of them and layouts, which replace the files of an archetype.
+//
+//go:embed all:templates
+var templates embed.FS
+
+// partials holds the named templates which every project file can use.
+//
+//go:embed templates/partials.tmpl
+var partials string
+
+//nolint:gochecknoglobals // function map shared by all the project templates.
+var templateFuncs = template.FuncMap{
+	"pascal": pascalCase,
+	"snake":  snakeCase,
+	"lower":  strings.ToLower,
+	"base":   path.Base,
+	// the helpers of the protoc output of the grpc template.
+	"unexport":        unexport,
+	"protoIdent":      protoIdent,
+	"protoDescriptor": protoDescriptor,
+}
+
+// file is a rendered project file, path is relative to the project root.
+type file struct {
+	path    string
+	content []byte
+	// merge marks a file outside the project which is updated in place, like go.work, rather than created.
+	merge bool
+}
+
+// projectTemplates returns the names of the archetypes available for init.
+func projectTemplates() []string {
+	entries, _ := templates.ReadDir(templateDir)
+
+	names := make([]string, 0, len(entries))
+
+	for _, e := range entries {
+		if e.IsDir() && e.Name() != commonTemplate && e.Name() != layoutsDir {
+			names = append(names, e.Name())
+		}
+	}
+
+	return names
+}
+
+// renderProject renders the common files and the files of the given archetype. With the layered layout
+// the files of the archetype are replaced by handler, service and store packages.
+func renderProject(archetype, layout string, data *modInfo) ([]file, error) {
+	if !slices.Contains(projectTemplates(), archetype) {
+		return nil, fmt.Errorf("%w %q, available templates: %s", errUnknownTemplate, archetype,
+			strings.Join(projectTemplates(), ", "))
+	}
+
+	dir := path.Join(templateDir, archetype)
+
+	switch layout {
+	case "":
+	case layeredLayout:
+		if !layeredTemplate(archetype) {
+			return nil, errLayoutTemplate
+		}
+
+		dir = path.Join(templateDir, layoutsDir, layout)
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownLayout, layout)
+	}
+
+	files, err := renderTree(templates, path.Join(templateDir, commonTemplate), data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	archetypeFiles, err := renderTree(templates, dir, data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	files = append(files, archetypeFiles...)
+
+	if archetype == grpcTemplate {
+		wrappers, wrapErr := grpcWrappers(data, files)
+		if wrapErr != nil {
+			return nil, wrapErr
+		}
+
+		files = append(files, wrappers...)
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].path < files[j].path })
+
+	return files, nil
+}
+
+// layeredTemplate reports whether the layered layout can replace the files of the archetype, which
+// is the case for the HTTP services.
+func layeredTemplate(archetype string) bool {
+	return archetype == basicTemplate || archetype == "rest"
+}
+
+// renderTree executes every file below root as a text/template with the given data. File paths are
+// templates as well, so that a file can be named after the project. Go files are formatted with gofmt.
+// Files and directories for which skip, when not nil, reports true are left out.
+func renderTree(fsys fs.FS, root string, data any, skip func(rel string) bool) ([]file, error) {
+	var files []file
+
+	err := fs.WalkDir(fsys, root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || p == root {
+			return err
+		}
+
+		rel := strings.TrimPrefix(p, root+"/")
+
+		if skip != nil && skip(rel) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+
+			return nil
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		renderedPath, err := execute(rel, p, data)
+		if err != nil {
+			return err
+		}
+
+		content, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+
+		rendered, err := execute(string(content), p, data)
+		if err != nil {
+			return err
+		}
+
+		f := file{path: strings.TrimSuffix(string(renderedPath), templateSuffix), content: rendered}
+
+		if strings.HasSuffix(f.path, ".go") {
+			if f.content, err = format.Source(rendered); err != nil {
+				return fmt.Errorf("formatting %s: %w", p, err)
+			}
+		}
+
+		files = append(files, f)
+
+		return nil
+	})
+
+	return files, err
+}
+
+func execute(text, name string, data any) ([]byte, error) {
+	// a missing key is most likely a -var which was not given, rendering "<no value>" would hide it.
+	t, err := template.New(name).Option("missingkey=error").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	// partials has no body of its own, so parsing it after text only adds its definitions.
+	t, err = t.Parse(partials)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	err = t.Execute(&buf, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// pascalCase converts a project name like "order-service" into an exported Go identifier, "OrderService".
+func pascalCase(s string) string {
+	var b strings.Builder
+
+	upper := true
+
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// snakeCase converts a project name like "order-service" into "order_service", usable as a database name.
+func snakeCase(s string) string {
+	return strings.Map(func(r rune) rune {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return '_'
+		}
+
+		return unicode.ToLower(r)
+	}, s)
+}
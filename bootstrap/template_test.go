@@ -0,0 +1,86 @@
+package bootstrap
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_renderProject(t *testing.T) {
+	common := []string{"configs/.env", "configs/.local.env", "configs/.test.env", "go.mod"}
+
+	layered := []string{"handler/customer.go", "handler/customer_test.go", "handler/interfaces.go",
+		"handler/mock_interfaces.go", "main.go", "models/customer.go", "service/customer.go", "service/customer_test.go",
+		"service/interfaces.go", "service/mock_interfaces.go", "store/customer.go", "store/customer_test.go"}
+
+	tests := []struct {
+		archetype string
+		layout    string
+		files     []string
+	}{
+		{basicTemplate, "", []string{"main.go"}},
+		{"rest", "", []string{"handler/greeting.go", "handler/greeting_test.go", "main.go", "store/greeting.go"}},
+		{"grpc", "", []string{"main.go", "server/generate.go", "server/health_gofr.go", "server/orders.pb.go",
+			"server/orders.proto", "server/orders_grpc.pb.go", "server/ordersservice_gofr.go",
+			"server/ordersservice_server.go", "server/ordersservice_server_test.go", "server/request_gofr.go"}},
+		{"pubsub", "", []string{"main.go", "subscriber/event.go", "subscriber/event_test.go"}},
+		{"cron", "", []string{"job/cleanup.go", "job/cleanup_test.go", "main.go"}},
+		{"cmd", "", []string{"command/hello.go", "command/hello_test.go", "main.go"}},
+		{basicTemplate, layeredLayout, layered},
+		{"rest", layeredLayout, layered},
+	}
+
+	for i, tc := range tests {
+		files, err := renderProject(tc.archetype, tc.layout, &modInfo{Module: "github.com/acme/orders", Name: "orders",
+			GofrVersion: "v1.28.0", GoVersion: "1.22", Template: tc.archetype})
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.archetype)
+
+		paths := make([]string, 0, len(files))
+		for _, f := range files {
+			paths = append(paths, f.path)
+		}
+
+		want := append(append([]string{}, common...), tc.files...)
+		sort.Strings(want)
+
+		assert.Equal(t, want, paths, "TEST[%d], Failed.\n%s", i, tc.archetype)
+	}
+}
+
+func Test_renderProject_Configs(t *testing.T) {
+	files, err := renderProject("grpc", "", &modInfo{Module: "github.com/acme/orders", Name: "orders", Template: "grpc"})
+	require.NoError(t, err)
+
+	env := string(files[0].content)
+
+	assert.Equal(t, "configs/.env", files[0].path)
+	assert.Contains(t, env, "APP_NAME=orders\n")
+	assert.Contains(t, env, "HTTP_PORT=8000\n")
+	assert.Contains(t, env, "METRICS_PORT=2121\n")
+	assert.Contains(t, env, "GRPC_PORT=9000\n")
+	assert.NotContains(t, env, "CMD_LOGS_FILE")
+}
+
+func Test_renderProject_UnknownTemplate(t *testing.T) {
+	for _, archetype := range []string{"graphql", commonTemplate, layoutsDir} {
+		_, err := renderProject(archetype, "", &modInfo{})
+
+		assert.ErrorIs(t, err, errUnknownTemplate, archetype)
+	}
+}
+
+func Test_renderProject_Layout(t *testing.T) {
+	_, err := renderProject("cmd", layeredLayout, &modInfo{})
+	require.ErrorIs(t, err, errLayoutTemplate)
+
+	_, err = renderProject("rest", "hexagonal", &modInfo{})
+	require.ErrorIs(t, err, errUnknownLayout)
+}
+
+func Test_pascalCase(t *testing.T) {
+	assert.Equal(t, "OrderService", pascalCase("order-service"))
+	assert.Equal(t, "Orders", pascalCase("orders"))
+	assert.Equal(t, "OrderV2Api", pascalCase("order_v2.api"))
+}
@@ -0,0 +1,84 @@
+package bootstrap
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	"golang.org/x/mod/semver"
+
+	"gofr.dev/cli/gofr/modcache"
+)
+
+const (
+	gofrModule = "gofr.dev"
+
+	// fallbackGoVersion is used for the go directive when no Go toolchain can be found.
+	fallbackGoVersion = "1.22"
+)
+
+var (
+	goVersionPattern = regexp.MustCompile(`^go(\d+\.\d+(?:\.\d+)?(?:(?:rc|beta)\d+)?)`)
+
+	errInvalidGofrVersion = errors.New("invalid gofr version, expected a semantic version like 1.28.0")
+	errNoGofrVersion      = errors.New(`unable to find a gofr version, please provide it using "-gofr" option`)
+)
+
+// resolveGofrVersion returns the gofr.dev version, prefixed with v, the project requires. An explicit version
+// must be a semantic version, otherwise the newest of the versions in the local module cache and the version
+// the CLI was built against is used.
+func resolveGofrVersion(explicit string) (string, error) {
+	if explicit != "" {
+		v := "v" + strings.TrimPrefix(explicit, "v")
+
+		if !semver.IsValid(v) || semver.Canonical(v) != v {
+			return "", fmt.Errorf("%w, got %q", errInvalidGofrVersion, explicit)
+		}
+
+		return v, nil
+	}
+
+	versions := modcache.Versions(modcache.GoEnv("GOMODCACHE"), gofrModule)
+
+	if v := buildVersion(gofrModule); v != "" {
+		versions = append(versions, v)
+	}
+
+	if len(versions) == 0 {
+		return "", errNoGofrVersion
+	}
+
+	semver.Sort(versions)
+
+	return versions[len(versions)-1], nil
+}
+
+// buildVersion returns the version of module the CLI was built against.
+func buildVersion(module string) string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path == module && semver.IsValid(dep.Version) {
+			return dep.Version
+		}
+	}
+
+	return ""
+}
+
+// resolveGoVersion returns the version of the installed Go toolchain in the format of the go directive.
+func resolveGoVersion() string {
+	for _, v := range []string{modcache.GoEnv("GOVERSION"), runtime.Version()} {
+		if m := goVersionPattern.FindStringSubmatch(v); m != nil {
+			return m[1]
+		}
+	}
+
+	return fallbackGoVersion
+}
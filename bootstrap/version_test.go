@@ -0,0 +1,33 @@
+package bootstrap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_resolveGofrVersion_Explicit(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+		err   error
+	}{
+		{"1.28.0", "v1.28.0", nil},
+		{"v1.30.1", "v1.30.1", nil},
+		{"v1.31.0-rc.1", "v1.31.0-rc.1", nil},
+		{"1.28", "", errInvalidGofrVersion},
+		{"latest", "", errInvalidGofrVersion},
+	}
+
+	for i, tc := range tests {
+		v, err := resolveGofrVersion(tc.value)
+
+		require.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.value)
+		assert.Equal(t, tc.want, v, "TEST[%d], Failed.\n%s", i, tc.value)
+	}
+}
+
+func Test_resolveGoVersion(t *testing.T) {
+	assert.Regexp(t, `^\d+\.\d+`, resolveGoVersion())
+}
@@ -0,0 +1,199 @@
+package bootstrap
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/term"
+)
+
+var errNoAnswer = errors.New("init aborted, no answer given")
+
+// wizard prompts for the init options which were not given as flags. It reads one answer per line,
+// so it can be scripted by piping the answers into gofr init -interactive.
+type wizard struct {
+	in  *bufio.Scanner
+	out io.Writer
+}
+
+func newWizard(in io.Reader, out io.Writer) *wizard {
+	return &wizard{in: bufio.NewScanner(in), out: out}
+}
+
+// run asks for every option flagged in asked and stores the answers in opts.
+func (w *wizard) run(opts *options, asked map[string]bool) error {
+	questions := []struct {
+		option string
+		ask    func(*options) error
+	}{
+		{"name", w.askModule},
+		{"gofr", w.askGofrVersion},
+		{"template", w.askTemplate},
+		{"layout", func(o *options) error {
+			if o.archetype == "" {
+				o.archetype = basicTemplate
+			}
+
+			if layeredTemplate(o.archetype) && w.confirm("Split the project into handler, service and store layers?", false) {
+				o.layout = layeredLayout
+			}
+
+			return nil
+		}},
+		{"db", w.askDatasources},
+		{"docker", func(o *options) error {
+			o.docker = w.confirm("Generate a Dockerfile and docker-compose.yml?", false)
+			return nil
+		}},
+		{"k8s", func(o *options) error {
+			// command line applications serve no HTTP, there is nothing to probe in kubernetes.
+			o.k8s = o.archetype != cmdTemplate && w.confirm("Generate kubernetes manifests?", false)
+			return nil
+		}},
+		{"tests", func(o *options) error {
+			o.tests = w.confirm("Generate tests?", true)
+			return nil
+		}},
+	}
+
+	for _, q := range questions {
+		if !asked[q.option] {
+			continue
+		}
+
+		if err := q.ask(opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *wizard) askModule(o *options) (err error) {
+	o.module, err = w.ask("Module path, e.g. github.com/acme/orders", "", module.CheckImportPath)
+
+	return err
+}
+
+func (w *wizard) askGofrVersion(o *options) (err error) {
+	latest, _ := resolveGofrVersion("")
+
+	o.gofrVersion, err = w.ask("GoFr version", latest, validGofrVersion)
+
+	return err
+}
+
+func validGofrVersion(v string) error {
+	_, err := resolveGofrVersion(v)
+
+	return err
+}
+
+func (w *wizard) askTemplate(o *options) (err error) {
+	available := projectTemplates()
+
+	o.archetype, err = w.ask("Project template ("+strings.Join(available, ", ")+")", basicTemplate, func(v string) error {
+		if !slices.Contains(available, v) {
+			return fmt.Errorf("%w %q", errUnknownTemplate, v)
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func (w *wizard) askDatasources(o *options) (err error) {
+	o.db, err = w.ask("Datasources, comma separated (postgres, mysql, sqlite, redis, mongo)", "", validDatasources)
+
+	return err
+}
+
+func validDatasources(v string) error {
+	_, err := parseDatasources(v)
+
+	return err
+}
+
+// ask prompts until the answer passes validate, an empty answer selects def. A required
+// question, one without default, fails when the input ends before it is answered.
+func (w *wizard) ask(question, def string, validate func(string) error) (string, error) {
+	for {
+		if def != "" {
+			fmt.Fprintf(w.out, "%s [%s]: ", question, def)
+		} else {
+			fmt.Fprintf(w.out, "%s: ", question)
+		}
+
+		answer, ok := w.readLine()
+		if answer == "" {
+			answer = def
+		}
+
+		if answer == "" && def == "" && !ok {
+			return "", fmt.Errorf("%w for %q", errNoAnswer, question)
+		}
+
+		err := validate(answer)
+		if err == nil {
+			return answer, nil
+		}
+
+		if !ok {
+			return "", err
+		}
+
+		fmt.Fprintf(w.out, "invalid answer: %v\n", err)
+	}
+}
+
+// confirm prompts for a yes or no answer, an empty answer or the end of the input selects def.
+func (w *wizard) confirm(question string, def bool) bool {
+	choices := "y/N"
+	if def {
+		choices = "Y/n"
+	}
+
+	for {
+		fmt.Fprintf(w.out, "%s [%s]: ", question, choices)
+
+		answer, ok := w.readLine()
+
+		switch strings.ToLower(answer) {
+		case "":
+			return def
+		case "y", "yes":
+			return true
+		case "n", "no":
+			return false
+		}
+
+		if !ok {
+			return def
+		}
+
+		fmt.Fprintln(w.out, "invalid answer: please answer y or n")
+	}
+}
+
+// readLine reads the next answer, ok is false once the input has ended.
+func (w *wizard) readLine() (answer string, ok bool) {
+	if !w.in.Scan() {
+		fmt.Fprintln(w.out)
+
+		return "", false
+	}
+
+	return strings.TrimSpace(w.in.Text()), true
+}
+
+// isTerminal reports whether f is an interactive terminal rather than a pipe, a file or /dev/null.
+func isTerminal(f *os.File) bool {
+	return term.IsTerminal(int(f.Fd()))
+}
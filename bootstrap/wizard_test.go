@@ -0,0 +1,87 @@
+package bootstrap
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func allAsked() map[string]bool {
+	return map[string]bool{"name": true, "gofr": true, "template": true, "layout": true, "db": true, "docker": true,
+		"k8s": true, "tests": true}
+}
+
+func Test_wizard(t *testing.T) {
+	answers := strings.Join([]string{
+		"",                       // module path is required, asked again
+		"github.com/acme/orders", // module path
+		"1.2",                    // invalid gofr version, asked again
+		"1.30.0",                 // gofr version
+		"graphql",                // unknown template, asked again
+		"rest",                   // template
+		"y",                      // layered layout
+		"postgres,redis",         // datasources
+		"y",                      // docker
+		"maybe",                  // invalid confirmation, asked again
+		"n",                      // k8s
+		"",                       // tests, default yes
+	}, "\n")
+
+	var out bytes.Buffer
+
+	opts := options{}
+
+	require.NoError(t, newWizard(strings.NewReader(answers), &out).run(&opts, allAsked()))
+
+	assert.Equal(t, options{module: "github.com/acme/orders", gofrVersion: "1.30.0", archetype: "rest",
+		layout: layeredLayout, db: "postgres,redis", docker: true, tests: true}, opts)
+	assert.Contains(t, out.String(), "invalid answer: "+errInvalidGofrVersion.Error())
+	assert.Contains(t, out.String(), `invalid answer: unknown project template "graphql"`)
+}
+
+func Test_wizard_OnlyMissingOptions(t *testing.T) {
+	var out bytes.Buffer
+
+	opts := options{archetype: "cmd", db: "sqlite", tests: true}
+
+	require.NoError(t, newWizard(strings.NewReader("orders\n"), &out).run(&opts, map[string]bool{"name": true}))
+
+	assert.Equal(t, options{module: "orders", archetype: "cmd", db: "sqlite", tests: true}, opts)
+	assert.Equal(t, "Module path, e.g. github.com/acme/orders: ", out.String())
+}
+
+func Test_wizard_Layout(t *testing.T) {
+	tests := []struct {
+		archetype string
+		answers   string
+		layout    string
+	}{
+		{"", "y\n", layeredLayout},
+		{"rest", "n\n", ""},
+		{"rest", "\n", ""},
+		// the layered layout only replaces the basic and rest templates, it is not asked for the others.
+		{"cmd", "y\n", ""},
+	}
+
+	for i, tc := range tests {
+		var out bytes.Buffer
+
+		opts := options{archetype: tc.archetype}
+
+		err := newWizard(strings.NewReader(tc.answers), &out).run(&opts, map[string]bool{"layout": true})
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.archetype)
+
+		assert.Equal(t, tc.layout, opts.layout, "TEST[%d], Failed.\n%s", i, tc.archetype)
+	}
+}
+
+func Test_wizard_InputEnded(t *testing.T) {
+	var out bytes.Buffer
+
+	err := newWizard(strings.NewReader(""), &out).run(&options{}, allAsked())
+
+	require.ErrorIs(t, err, errNoAnswer)
+}
@@ -0,0 +1,190 @@
+package bootstrap
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+)
+
+const (
+	workFile  = "go.work"
+	sharedDir = "shared"
+	protoDir  = "proto"
+	serverDir = "server"
+
+	sharedModTemplate = "module {{ .Workspace.Shared }}\n\ngo {{ .GoVersion }}\n\nrequire gofr.dev {{ .GofrVersion }}\n"
+)
+
+// workspace is the go.work workspace a project is initialized in. The proto and migration packages of
+// its projects live in a shared module next to go.work, so that sibling services can import them.
+type workspace struct {
+	// Shared is the module path of the shared module.
+	Shared string
+	// SharedDir is the directory of the shared module, relative to the project.
+	SharedDir string
+
+	// root is the directory of go.work, relative to the project.
+	root string
+	// project is the directory of the project, relative to go.work.
+	project string
+	work    *modfile.WorkFile
+	// sharedExists reports whether the shared module was created before.
+	sharedExists bool
+}
+
+// openWorkspace returns the workspace enclosing the working directory, in which the project is created in
+// the directory named dir. Without a go.work in the working directory or its parents, a workspace is only
+// created, in the working directory, when create is set, otherwise the project is standalone and nil is returned.
+func openWorkspace(dir, module string, create bool) (*workspace, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	root, found := findWorkspace(cwd)
+	if !found && !create {
+		return nil, nil
+	}
+
+	ws := &workspace{work: &modfile.WorkFile{Syntax: &modfile.FileSyntax{}}}
+
+	if found {
+		var data []byte
+
+		data, err = os.ReadFile(filepath.Join(root, workFile))
+		if err != nil {
+			return nil, err
+		}
+
+		ws.work, err = modfile.ParseWork(workFile, data, nil)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		root = cwd
+	}
+
+	project := filepath.Join(cwd, dir)
+
+	if ws.root, err = filepath.Rel(project, root); err != nil {
+		return nil, err
+	}
+
+	if ws.project, err = filepath.Rel(root, project); err != nil {
+		return nil, err
+	}
+
+	ws.root, ws.project = filepath.ToSlash(ws.root), filepath.ToSlash(ws.project)
+	ws.SharedDir = path.Join(ws.root, sharedDir)
+	ws.Shared, ws.sharedExists = sharedModule(filepath.Join(root, sharedDir), module)
+
+	return ws, nil
+}
+
+// findWorkspace returns the directory of the go.work in dir or the closest of its parents.
+func findWorkspace(dir string) (string, bool) {
+	for {
+		if info, err := os.Stat(filepath.Join(dir, workFile)); err == nil && !info.IsDir() {
+			return dir, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+
+		dir = parent
+	}
+}
+
+// sharedModule returns the module path of the shared module in dir. When it does not exist yet, the path
+// is derived from the module of the project, the shared module of github.com/acme/orders being
+// github.com/acme/shared.
+func sharedModule(dir, module string) (modulePath string, exists bool) {
+	if data, err := os.ReadFile(filepath.Join(dir, "go.mod")); err == nil {
+		if p := modfile.ModulePath(data); p != "" {
+			return p, true
+		}
+	}
+
+	if majorVersion.MatchString(path.Base(module)) {
+		module = path.Dir(module)
+	}
+
+	if parent := path.Dir(module); parent != "." {
+		return parent + "/" + sharedDir, false
+	}
+
+	return sharedDir, false
+}
+
+// files moves the project files which belong to the shared module into it and adds the changes of the
+// workspace: the use directives in go.work and the go.mod of the shared module when it does not exist
+// yet. All paths are relative to the project.
+func (ws *workspace) files(info *modInfo, files []file) ([]file, error) {
+	moved := make([]file, 0, len(files)+2)
+
+	for _, f := range files {
+		f.path = ws.sharedPath(info, f.path)
+		moved = append(moved, f)
+	}
+
+	if ws.work.Go == nil {
+		if err := ws.work.AddGoStmt(info.GoVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ws.addUse(ws.project, info.Module); err != nil {
+		return nil, err
+	}
+
+	if !ws.sharedExists && info.UsesShared() {
+		if err := ws.addUse(sharedDir, ws.Shared); err != nil {
+			return nil, err
+		}
+
+		gomod, err := execute(sharedModTemplate, "shared/go.mod", info)
+		if err != nil {
+			return nil, err
+		}
+
+		moved = append(moved, file{path: path.Join(ws.SharedDir, "go.mod"), content: gomod})
+	}
+
+	ws.work.Cleanup()
+
+	return append(moved, file{path: path.Join(ws.root, workFile), content: modfile.Format(ws.work.Syntax), merge: true}), nil
+}
+
+// addUse adds a use directive for dir, relative to go.work, unless the workspace uses it already.
+func (ws *workspace) addUse(dir, module string) error {
+	for _, u := range ws.work.Use {
+		if path.Clean(u.Path) == path.Clean(dir) {
+			return nil
+		}
+	}
+
+	return ws.work.AddUse("./"+dir, module)
+}
+
+// sharedPath moves the migration and proto packages of the project into the shared module, to
+// <project>/migrations and proto/<project> respectively.
+func (ws *workspace) sharedPath(info *modInfo, p string) string {
+	if !info.UsesShared() {
+		return p
+	}
+
+	if rest, ok := strings.CutPrefix(p, migrationsDir+"/"); ok {
+		return path.Join(ws.SharedDir, info.SharedPackage(), migrationsDir, rest)
+	}
+
+	if rest, ok := strings.CutPrefix(p, serverDir+"/"); ok && info.Template == grpcTemplate {
+		return path.Join(ws.SharedDir, protoDir, info.SharedPackage(), rest)
+	}
+
+	return p
+}
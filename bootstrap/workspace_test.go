@@ -0,0 +1,81 @@
+package bootstrap
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chdir changes the working directory to dir for the duration of the test.
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	require.NoError(t, os.Chdir(dir))
+
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+}
+
+func Test_generate_Workspace(t *testing.T) {
+	root := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, workFile), []byte("go 1.22\n\nuse ./services/billing\n"), fileMode))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "services"), dirMode))
+
+	chdir(t, filepath.Join(root, "services"))
+
+	files, err := generate(&options{module: "github.com/acme/orders", gofrVersion: "1.28.0", archetype: "grpc",
+		db: "postgres", tests: true})
+	require.NoError(t, err)
+
+	rendered := make(map[string]string)
+	for _, f := range files {
+		rendered[f.path] = string(f.content)
+	}
+
+	assert.Equal(t, "go 1.22\n\nuse (\n\t./services/billing\n\t./services/orders\n\t./shared\n)\n", rendered["../../go.work"])
+	assert.Contains(t, rendered["../../shared/go.mod"], "module github.com/acme/shared\n")
+	assert.Contains(t, rendered["go.mod"], "replace github.com/acme/shared => ../../shared\n")
+	assert.Contains(t, rendered["main.go"], `"github.com/acme/shared/orders/migrations"`)
+	assert.Contains(t, rendered["../../shared/proto/orders/orders.proto"], `option go_package = "github.com/acme/shared/proto/orders";`)
+	assert.Contains(t, rendered["../../shared/proto/orders/generate.go"], "package orders\n")
+	assert.Contains(t, rendered["../../shared/proto/orders/orders.pb.go"], "package orders\n")
+	assert.Contains(t, rendered["main.go"], "orders.RegisterOrdersServiceServerWithGofr(app, orders.NewOrdersServiceGoFrServer())")
+	assert.Contains(t, rendered, "../../shared/orders/migrations/all.go")
+	assert.NotContains(t, rendered, "server/generate.go")
+}
+
+func Test_generate_CreateWorkspace(t *testing.T) {
+	chdir(t, t.TempDir())
+
+	files, err := generate(&options{module: "github.com/acme/orders", gofrVersion: "1.28.0", workspace: true, tests: true})
+	require.NoError(t, err)
+
+	paths := make(map[string]file)
+	for _, f := range files {
+		paths[f.path] = f
+	}
+
+	work := paths["../go.work"]
+
+	assert.True(t, work.merge)
+	assert.Contains(t, string(work.content), "use ./orders\n")
+	assert.NotContains(t, string(work.content), "shared", "a project without migrations or protos needs no shared module")
+	assert.NotContains(t, paths, "../shared/go.mod")
+}
+
+func Test_generate_Standalone(t *testing.T) {
+	chdir(t, t.TempDir())
+
+	files, err := generate(&options{module: "github.com/acme/orders", gofrVersion: "1.28.0", db: "redis", tests: true})
+	require.NoError(t, err)
+
+	for _, f := range files {
+		assert.NotContains(t, f.path, "..")
+	}
+}
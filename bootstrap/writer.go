@@ -0,0 +1,126 @@
+package bootstrap
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var errFilesExist = errors.New("project files already exist, use -force to merge the project into them")
+
+// projectWriter writes the project files below root and keeps track of everything it changed,
+// so that an init which fails halfway can be rolled back without leaving a broken project behind.
+type projectWriter struct {
+	root  string
+	force bool
+
+	// created holds the files and directories created by the writer, in creation order.
+	created []string
+	// backups holds the original content of the files overwritten with -force.
+	backups map[string][]byte
+}
+
+func newProjectWriter(root string, force bool) *projectWriter {
+	return &projectWriter{root: root, force: force, backups: make(map[string][]byte)}
+}
+
+// conflicts returns the files which already exist in the project directory, files which are merged never conflict.
+func (w *projectWriter) conflicts(files []file) []string {
+	var existing []string
+
+	for _, f := range files {
+		if _, err := os.Stat(w.path(f.path)); err == nil && !f.merge {
+			existing = append(existing, f.path)
+		}
+	}
+
+	return existing
+}
+
+// check fails when any of the files exists, unless the writer is allowed to merge.
+func (w *projectWriter) check(files []file) error {
+	existing := w.conflicts(files)
+	if len(existing) == 0 || w.force {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", errFilesExist, strings.Join(existing, ", "))
+}
+
+func (w *projectWriter) write(f file) error {
+	p := w.path(f.path)
+
+	if err := w.mkdirAll(filepath.Dir(p)); err != nil {
+		return err
+	}
+
+	flag := os.O_CREATE | os.O_WRONLY | os.O_EXCL
+
+	if original, err := os.ReadFile(p); err == nil {
+		if !w.force && !f.merge {
+			return fmt.Errorf("%w: %s", errFilesExist, f.path)
+		}
+
+		if _, ok := w.backups[p]; !ok {
+			w.backups[p] = original
+		}
+
+		flag = os.O_WRONLY | os.O_TRUNC
+	} else {
+		w.created = append(w.created, p)
+	}
+
+	out, err := os.OpenFile(p, flag, fileMode)
+	if err != nil {
+		return err
+	}
+
+	_, err = out.Write(f.content)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// mkdirAll creates dir and its missing parents, remembering each one it created.
+func (w *projectWriter) mkdirAll(dir string) error {
+	if _, err := os.Stat(dir); err == nil {
+		return nil
+	}
+
+	if err := w.mkdirAll(filepath.Dir(dir)); err != nil {
+		return err
+	}
+
+	if err := os.Mkdir(dir, dirMode); err != nil {
+		return err
+	}
+
+	w.created = append(w.created, dir)
+
+	return nil
+}
+
+// rollback removes everything the writer created and restores the files it overwrote.
+func (w *projectWriter) rollback() error {
+	var errs []error
+
+	for p, content := range w.backups {
+		errs = append(errs, os.WriteFile(p, content, fileMode))
+	}
+
+	for i := len(w.created) - 1; i >= 0; i-- {
+		errs = append(errs, os.Remove(w.created[i]))
+	}
+
+	w.created, w.backups = nil, make(map[string][]byte)
+
+	return errors.Join(errs...)
+}
+
+func (w *projectWriter) path(rel string) string {
+	return filepath.Join(w.root, filepath.FromSlash(rel))
+}
@@ -0,0 +1,63 @@
+package bootstrap
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_projectWriter_Conflicts(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module existing\n"), fileMode))
+
+	files := []file{{path: "go.mod", content: []byte("module orders\n")}, {path: "main.go", content: []byte("package main\n")}}
+
+	err := newProjectWriter(root, false).check(files)
+	require.ErrorIs(t, err, errFilesExist)
+	assert.Contains(t, err.Error(), "go.mod")
+
+	require.NoError(t, newProjectWriter(root, true).check(files))
+}
+
+func Test_projectWriter_Rollback(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "orders")
+	require.NoError(t, os.MkdirAll(root, dirMode))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module existing\n"), fileMode))
+
+	w := newProjectWriter(root, true)
+
+	require.NoError(t, w.write(file{path: "go.mod", content: []byte("module orders\n")}))
+	require.NoError(t, w.write(file{path: "handler/greeting.go", content: []byte("package handler\n")}))
+
+	content, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	require.NoError(t, err)
+	assert.Equal(t, "module orders\n", string(content))
+
+	require.NoError(t, w.rollback())
+
+	content, err = os.ReadFile(filepath.Join(root, "go.mod"))
+	require.NoError(t, err)
+	assert.Equal(t, "module existing\n", string(content), "overwritten file is restored")
+	assert.NoDirExists(t, filepath.Join(root, "handler"), "created directory is removed")
+}
+
+func Test_projectWriter_RollbackNewProject(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "orders")
+
+	w := newProjectWriter(root, false)
+
+	require.NoError(t, w.write(file{path: "configs/.env", content: []byte("APP_NAME=orders\n")}))
+	require.NoError(t, w.rollback())
+
+	assert.NoDirExists(t, root)
+}
+
+func Test_projectName(t *testing.T) {
+	assert.Equal(t, "orders", projectName("github.com/acme/orders"))
+	assert.Equal(t, "orders", projectName("github.com/acme/orders/v2"))
+	assert.Equal(t, "orders", projectName("orders"))
+	assert.Equal(t, "v2", projectName("v2"))
+}
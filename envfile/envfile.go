@@ -0,0 +1,176 @@
+// Package envfile reads the dotenv files GoFr loads its configuration from, e.g. configs/.env.
+package envfile
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Env holds the variables of a dotenv file in the order they are defined in, along with its lines so that it can
+// be written back with its comments.
+type Env struct {
+	keys   []string
+	values map[string]string
+	lines  []string
+	// defined is the index of the line defining each key, the last one for keys defined twice.
+	defined map[string]int
+}
+
+// Read parses the dotenv file at path.
+func Read(path string) (*Env, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return Parse(f)
+}
+
+// Parse parses dotenv content. Blank lines and comments are skipped, values may be quoted
+// and unquoted values end at an inline comment. A key defined twice keeps its last value.
+func Parse(r io.Reader) (*Env, error) {
+	env := &Env{}
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		env.lines = append(env.lines, scanner.Text())
+
+		line := strings.TrimSpace(scanner.Text())
+		line = strings.TrimPrefix(line, "export ")
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+
+		key = strings.TrimSpace(key)
+
+		env.define(key, parseValue(strings.TrimSpace(value)))
+		env.defined[key] = len(env.lines) - 1
+	}
+
+	return env, scanner.Err()
+}
+
+func parseValue(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') {
+		if end := closingQuote(value); end > 0 {
+			if value[0] == '"' {
+				if unquoted, err := strconv.Unquote(value[:end+1]); err == nil {
+					return unquoted
+				}
+			}
+
+			return value[1:end]
+		}
+	}
+
+	if i := strings.Index(value, " #"); i >= 0 {
+		value = value[:i]
+	}
+
+	return strings.TrimSpace(value)
+}
+
+// closingQuote returns the index of the quote closing the value, or -1 when it is not closed. In double quoted
+// values a backslash escapes the next character, like formatValue writes an escaped quote.
+func closingQuote(value string) int {
+	for i := 1; i < len(value); i++ {
+		switch {
+		case value[0] == '"' && value[i] == '\\':
+			i++
+		case value[i] == value[0]:
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Get returns the value of key, or an empty string when it is not defined.
+func (e *Env) Get(key string) string {
+	return e.values[key]
+}
+
+// Lookup returns the value of key and whether it is defined.
+func (e *Env) Lookup(key string) (string, bool) {
+	v, ok := e.values[key]
+
+	return v, ok
+}
+
+// GetOrDefault returns the value of key, or def when it is not defined or empty.
+func (e *Env) GetOrDefault(key, def string) string {
+	if v := e.values[key]; v != "" {
+		return v
+	}
+
+	return def
+}
+
+// Set defines key, keeping its position when it is already defined. The line defining it is replaced, or
+// appended when it is not defined yet.
+func (e *Env) Set(key, value string) {
+	e.define(key, value)
+
+	line := key + "=" + formatValue(value)
+
+	i, ok := e.defined[key]
+	if !ok {
+		e.lines = append(e.lines, line)
+		e.defined[key] = len(e.lines) - 1
+
+		return
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(e.lines[i]), "export ") {
+		line = "export " + line
+	}
+
+	e.lines[i] = line
+}
+
+func (e *Env) define(key, value string) {
+	if e.values == nil {
+		e.values, e.defined = make(map[string]string), make(map[string]int)
+	}
+
+	if _, ok := e.values[key]; !ok {
+		e.keys = append(e.keys, key)
+	}
+
+	e.values[key] = value
+}
+
+// formatValue quotes the values which would not be read back as they are.
+func formatValue(value string) string {
+	if value != strings.TrimSpace(value) || strings.ContainsAny(value, "#\"'\\\n") {
+		return strconv.Quote(value)
+	}
+
+	return value
+}
+
+// Bytes returns the content of the dotenv file, with the lines it was parsed from and the variables set since.
+func (e *Env) Bytes() []byte {
+	if len(e.lines) == 0 {
+		return nil
+	}
+
+	return []byte(strings.Join(e.lines, "\n") + "\n")
+}
+
+// Keys returns the defined keys in the order of their definition.
+func (e *Env) Keys() []string {
+	return append([]string(nil), e.keys...)
+}
@@ -0,0 +1,121 @@
+package envfile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		content string
+		key     string
+		value   string
+		defined bool
+	}{
+		{"APP_NAME=orders\n", "APP_NAME", "orders", true},
+		{"APP_NAME = orders \n", "APP_NAME", "orders", true},
+		{"DB_PASSWORD=\"p#ss word\"\n", "DB_PASSWORD", "p#ss word", true},
+		{"DB_PASSWORD='p#ss' # the password\n", "DB_PASSWORD", "p#ss", true},
+		{"DB_PASSWORD=\"line\\nbreak\"\n", "DB_PASSWORD", "line\nbreak", true},
+		{"DB_PASSWORD=p#ss # the password\n", "DB_PASSWORD", "p#ss", true},
+		{"DB_PASSWORD=\"a\\\"b\" # the password\n", "DB_PASSWORD", "a\"b", true},
+		{"DB_PASSWORD=\"a\\\\\" # the password\n", "DB_PASSWORD", "a\\", true},
+		{"DB_PASSWORD='a\\'\n", "DB_PASSWORD", "a\\", true},
+		{"export HTTP_PORT=8080\n", "HTTP_PORT", "8080", true},
+		{"  export HTTP_PORT=\"8080\"\n", "HTTP_PORT", "8080", true},
+		{"HTTP_PORT=8000\nHTTP_PORT=8080\n", "HTTP_PORT", "8080", true},
+		{"REDIS_HOST=\n", "REDIS_HOST", "", true},
+		{"REDIS_HOST=\"\"\n", "REDIS_HOST", "", true},
+		{"# REDIS_HOST=localhost\n", "REDIS_HOST", "", false},
+		{"REDIS_HOST\n", "REDIS_HOST", "", false},
+	}
+
+	for i, tc := range tests {
+		env, err := Parse(strings.NewReader(tc.content))
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.content)
+
+		value, ok := env.Lookup(tc.key)
+
+		assert.Equal(t, tc.value, value, "TEST[%d], Failed.\n%s", i, tc.content)
+		assert.Equal(t, tc.defined, ok, "TEST[%d], Failed.\n%s", i, tc.content)
+	}
+}
+
+func TestParse_Keys(t *testing.T) {
+	env, err := Parse(strings.NewReader("APP_NAME=orders\nHTTP_PORT=8000\nAPP_NAME=payments\nREDIS_HOST=\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"APP_NAME", "HTTP_PORT", "REDIS_HOST"}, env.Keys())
+	assert.Equal(t, "payments", env.Get("APP_NAME"))
+	assert.Equal(t, "localhost", env.GetOrDefault("REDIS_HOST", "localhost"))
+}
+
+func TestEnv_Set(t *testing.T) {
+	content := "# Generated by gofr init.\nAPP_NAME=orders\n\nexport HTTP_PORT=8000 # the port\nHTTP_PORT=8080\n"
+
+	tests := []struct {
+		key   string
+		value string
+		want  string
+	}{
+		{"APP_NAME", "payments", "# Generated by gofr init.\nAPP_NAME=payments\n\nexport HTTP_PORT=8000 # the port\n" +
+			"HTTP_PORT=8080\n"},
+		{"HTTP_PORT", "9000", "# Generated by gofr init.\nAPP_NAME=orders\n\nexport HTTP_PORT=8000 # the port\n" +
+			"HTTP_PORT=9000\n"},
+		{"DB_PASSWORD", "p#ss word", "# Generated by gofr init.\nAPP_NAME=orders\n\nexport HTTP_PORT=8000 # the port\n" +
+			"HTTP_PORT=8080\nDB_PASSWORD=\"p#ss word\"\n"},
+		{"REDIS_HOST", "", "# Generated by gofr init.\nAPP_NAME=orders\n\nexport HTTP_PORT=8000 # the port\n" +
+			"HTTP_PORT=8080\nREDIS_HOST=\n"},
+	}
+
+	for i, tc := range tests {
+		env, err := Parse(strings.NewReader(content))
+		require.NoError(t, err)
+
+		env.Set(tc.key, tc.value)
+
+		assert.Equal(t, tc.want, string(env.Bytes()), "TEST[%d], Failed.\n%s", i, tc.key)
+
+		reparsed, err := Parse(strings.NewReader(string(env.Bytes())))
+		require.NoError(t, err)
+
+		assert.Equal(t, tc.value, reparsed.Get(tc.key), "TEST[%d], Failed.\n%s", i, tc.key)
+		assert.Equal(t, env.Keys(), reparsed.Keys(), "TEST[%d], Failed.\n%s", i, tc.key)
+	}
+}
+
+func TestEnv_SetRoundTrip(t *testing.T) {
+	values := []string{`a"b`, `a\`, `a\"b`, `"quoted"`, `it's`, " spaced ", "p#ss", "line\nbreak", `\n`}
+
+	for i, value := range values {
+		var env Env
+
+		env.Set("DB_PASSWORD", value)
+
+		reparsed, err := Parse(strings.NewReader(string(env.Bytes())))
+		require.NoError(t, err)
+
+		assert.Equal(t, value, reparsed.Get("DB_PASSWORD"), "TEST[%d], Failed.\n%s", i, env.Bytes())
+	}
+}
+
+func TestEnv_SetExported(t *testing.T) {
+	env, err := Parse(strings.NewReader("export HTTP_PORT=8000\n"))
+	require.NoError(t, err)
+
+	env.Set("HTTP_PORT", "8080")
+
+	assert.Equal(t, "export HTTP_PORT=8080\n", string(env.Bytes()))
+}
+
+func TestEnv_SetZeroValue(t *testing.T) {
+	var env Env
+
+	env.Set("APP_NAME", "orders")
+
+	assert.Equal(t, "orders", env.Get("APP_NAME"))
+	assert.Equal(t, "APP_NAME=orders\n", string(env.Bytes()))
+}
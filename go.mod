@@ -6,6 +6,10 @@ require (
 	github.com/emicklei/proto v1.13.3
 	github.com/stretchr/testify v1.10.0
 	gofr.dev v1.28.0
+	golang.org/x/mod v0.21.0
+	golang.org/x/term v0.26.0
+	golang.org/x/tools v0.26.0
+	google.golang.org/protobuf v1.35.2
 )
 
 require (
@@ -80,7 +84,6 @@ require (
 	golang.org/x/oauth2 v0.24.0 // indirect
 	golang.org/x/sync v0.9.0 // indirect
 	golang.org/x/sys v0.27.0 // indirect
-	golang.org/x/term v0.26.0 // indirect
 	golang.org/x/text v0.20.0 // indirect
 	golang.org/x/time v0.8.0 // indirect
 	google.golang.org/api v0.209.0 // indirect
@@ -88,7 +91,6 @@ require (
 	google.golang.org/genproto/googleapis/api v0.0.0-20241104194629-dd2ea8efbc28 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20241113202542-65e8d215514f // indirect
 	google.golang.org/grpc v1.67.1 // indirect
-	google.golang.org/protobuf v1.35.2 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	modernc.org/gc/v3 v3.0.0-20240107210532-573471604cb6 // indirect
 	modernc.org/libc v1.55.3 // indirect
@@ -3,8 +3,11 @@ package main
 import (
 	"gofr.dev/pkg/gofr"
 
+	"gofr.dev/cli/gofr/add"
 	"gofr.dev/cli/gofr/bootstrap"
 	"gofr.dev/cli/gofr/migration"
+	"gofr.dev/cli/gofr/openapi"
+	"gofr.dev/cli/gofr/routes"
 	"gofr.dev/cli/gofr/wrap"
 )
 
@@ -21,6 +24,34 @@ func main() {
 
 	cli.SubCommand("migrate create", migration.Migrate)
 
+	cli.SubCommand("migrate up", migration.Up)
+
+	cli.SubCommand("migrate status", migration.Status)
+
+	cli.SubCommand("routes", routes.List)
+
+	cli.SubCommand("openapi generate", openapi.Generate)
+
+	cli.SubCommand("add docker", add.Docker)
+
+	cli.SubCommand("add k8s", add.K8s)
+
+	cli.SubCommand("add entity", add.Entity)
+
+	cli.SubCommand("add handler", add.Handler)
+
+	cli.SubCommand("add datasource", add.Datasource)
+
+	cli.SubCommand("add subscriber", add.Subscriber)
+
+	cli.SubCommand("add cron", add.Cron)
+
+	cli.SubCommand("add websocket", add.WebSocket)
+
+	cli.SubCommand("add auth", add.Auth)
+
+	cli.SubCommand("add middleware", add.Middleware)
+
 	cli.SubCommand("wrap grpc server", wrap.BuildGRPCGoFrServer)
 
 	cli.SubCommand("wrap grpc client", wrap.BuildGRPCGoFrClient)
@@ -0,0 +1,225 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	migrationPackage = "gofr.dev/pkg/gofr/migration"
+	directive        = "//gofr:migration"
+)
+
+var (
+	errNoVersion        = errors.New("migration without version, prefix its file name with the version or add " + directive + " <version>")
+	errAmbiguous        = errors.New("more than one migration in a file versioned by its name, add " + directive + " <version> to them")
+	errNoFunction       = errors.New("no function returning migration.Migrate in the versioned file")
+	errDuplicateVersion = errors.New("more than one migration with the same version")
+	errDirective        = errors.New("invalid " + directive + " directive, it has to be followed by the version")
+)
+
+var filePrefix = regexp.MustCompile(`^(\d+)_`)
+
+// findMigrations returns the migrations in the directory dir, keyed by their version with the name of the migration
+// function as value, or the name of the file for migrations written in plain SQL. The functions are the ones without
+// parameters returning a migration.Migrate, their version is the one prefixing the name of their file or the one of
+// a //gofr:migration directive in their doc comment. Functions and versioned files which can not be told apart are
+// reported as errors. A directory which does not exist has no migrations.
+func findMigrations(dir string) (map[string]string, error) {
+	migrations := make(map[string]string)
+	// found are the files of the migrations, for the errors about duplicate versions.
+	found := make(map[string]string)
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return migrations, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	add := func(version, name, file string) error {
+		if other, ok := found[version]; ok {
+			return fmt.Errorf("%w: %s in %s and %s", errDuplicateVersion, version, other, file)
+		}
+
+		migrations[version], found[version] = name, file
+
+		return nil
+	}
+
+	for _, e := range entries {
+		name := e.Name()
+
+		switch {
+		case e.IsDir(), name == allFile, strings.HasSuffix(name, "_test.go"):
+			continue
+		case strings.HasSuffix(name, sqlSuffix):
+			if m := filePrefix.FindStringSubmatch(name); m != nil {
+				err = add(m[1], name, name)
+			}
+		case strings.HasSuffix(name, ".go"):
+			var functions map[string]string
+
+			functions, err = fileMigrations(filepath.Join(dir, name))
+			if err != nil {
+				return nil, err
+			}
+
+			for version, function := range functions {
+				if err = add(version, function, name); err != nil {
+					break
+				}
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return migrations, nil
+}
+
+// fileMigrations returns the migration functions of the Go file, keyed by their version.
+func fileMigrations(file string) (map[string]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	name := filepath.Base(file)
+	migrations := make(map[string]string)
+
+	var unversioned []string
+
+	for _, d := range f.Decls {
+		fn, ok := d.(*ast.FuncDecl)
+		if !ok || !returnsMigrate(f, fn) {
+			continue
+		}
+
+		version, ok, dirErr := directiveVersion(fn.Doc)
+		if dirErr != nil {
+			return nil, fmt.Errorf("%w: %s in %s", dirErr, fn.Name.Name, name)
+		}
+
+		if !ok {
+			unversioned = append(unversioned, fn.Name.Name)
+			continue
+		}
+
+		if other, exists := migrations[version]; exists {
+			return nil, fmt.Errorf("%w: %s of %s and %s in %s", errDuplicateVersion, version, other, fn.Name.Name, name)
+		}
+
+		migrations[version] = fn.Name.Name
+	}
+
+	prefix := filePrefix.FindStringSubmatch(name)
+
+	switch {
+	case len(unversioned) == 0:
+		if prefix != nil && len(migrations) == 0 {
+			return nil, fmt.Errorf("%w: %s", errNoFunction, name)
+		}
+	case prefix == nil:
+		return nil, fmt.Errorf("%w: %s in %s", errNoVersion, strings.Join(unversioned, ", "), name)
+	case len(unversioned) > 1:
+		return nil, fmt.Errorf("%w: %s in %s", errAmbiguous, strings.Join(unversioned, ", "), name)
+	default:
+		if other, exists := migrations[prefix[1]]; exists {
+			return nil, fmt.Errorf("%w: %s of %s and %s in %s", errDuplicateVersion, prefix[1], other, unversioned[0], name)
+		}
+
+		migrations[prefix[1]] = unversioned[0]
+	}
+
+	return migrations, nil
+}
+
+// returnsMigrate reports whether fn is a function without parameters returning a migration.Migrate, which all.go
+// can call to register the migration.
+func returnsMigrate(f *ast.File, fn *ast.FuncDecl) bool {
+	if fn.Recv != nil || fn.Type.TypeParams != nil || fn.Type.Params.NumFields() != 0 ||
+		fn.Type.Results == nil || len(fn.Type.Results.List) != 1 || len(fn.Type.Results.List[0].Names) > 1 {
+		return false
+	}
+
+	sel, ok := fn.Type.Results.List[0].Type.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Migrate" {
+		return false
+	}
+
+	pkg, ok := sel.X.(*ast.Ident)
+
+	return ok && pkg.Name == importName(f, migrationPackage)
+}
+
+// importName returns the name the file imports the package with, empty when it does not import it.
+func importName(f *ast.File, path string) string {
+	for _, spec := range f.Imports {
+		if p, err := strconv.Unquote(spec.Path.Value); err != nil || p != path {
+			continue
+		}
+
+		if spec.Name != nil {
+			return spec.Name.Name
+		}
+
+		return filepath.Base(path)
+	}
+
+	return ""
+}
+
+// directiveVersion returns the version of the //gofr:migration directive in the doc comment, if it has one.
+func directiveVersion(doc *ast.CommentGroup) (version string, ok bool, err error) {
+	if doc == nil {
+		return "", false, nil
+	}
+
+	for _, c := range doc.List {
+		rest, found := strings.CutPrefix(c.Text, directive)
+		if !found || (rest != "" && rest[0] != ' ' && rest[0] != '\t') {
+			continue
+		}
+
+		version = strings.TrimSpace(rest)
+		if _, parseErr := strconv.ParseInt(version, 10, 64); parseErr != nil {
+			return "", false, errDirective
+		}
+
+		return version, true, nil
+	}
+
+	return "", false, nil
+}
+
+// funcName returns the name of the function of the migration name, with the characters not allowed in identifiers
+// replaced by underscores.
+func funcName(name string) string {
+	r := []rune(name)
+
+	for i := range r {
+		if !unicode.IsLetter(r[i]) && !unicode.IsDigit(r[i]) && r[i] != '_' {
+			r[i] = '_'
+		}
+	}
+
+	if len(r) > 0 && unicode.IsDigit(r[0]) {
+		return "_" + string(r)
+	}
+
+	return string(r)
+}
@@ -0,0 +1,98 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const imports = "package migrations\n\nimport \"gofr.dev/pkg/gofr/migration\"\n\n"
+
+func TestFindMigrations(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFiles(t, dir, map[string]string{
+		// the function was renamed after the file was created.
+		"20240101120000_create_orders.go": migrationSource("createOrdersTable", "CREATE TABLE orders (id INTEGER)"),
+		"20240102120000_add-index.go":     migrationSource("add_index", "CREATE INDEX idx ON orders (id)"),
+		"20240103120000_seed.up.sql":      "INSERT INTO orders VALUES (1);\n",
+		"helpers_util.go":                 imports + "func exec(d migration.Datasource, query string) error {\n\treturn nil\n}\n",
+		"directives.go": "package migrations\n\nimport gm \"gofr.dev/pkg/gofr/migration\"\n\n" +
+			"//gofr:migration 20240104120000\nfunc addRefunds() gm.Migrate {\n\treturn gm.Migrate{}\n}\n\n" +
+			"// addPayments adds the table of the payments.\n//\n//gofr:migration 20240105120000\n" +
+			"func addPayments() gm.Migrate {\n\treturn gm.Migrate{}\n}\n",
+		"20240101120000_create_orders_test.go": imports + "func testMigration() migration.Migrate {\n\treturn migration.Migrate{}\n}\n",
+		"all.go":                               imports + "func All() map[int64]migration.Migrate {\n\treturn nil\n}\n",
+		"README.md":                            "# Migrations\n",
+	})
+
+	migrations, err := findMigrations(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"20240101120000": "createOrdersTable",
+		"20240102120000": "add_index",
+		"20240103120000": "20240103120000_seed.up.sql",
+		"20240104120000": "addRefunds",
+		"20240105120000": "addPayments",
+	}, migrations)
+
+	migrations, err = findMigrations(t.TempDir() + "/missing")
+	require.NoError(t, err)
+	assert.Empty(t, migrations)
+}
+
+func TestFindMigrations_Errors(t *testing.T) {
+	migrate := func(name, doc string) string {
+		return doc + "func " + name + "() migration.Migrate {\n\treturn migration.Migrate{}\n}\n\n"
+	}
+
+	tests := []struct {
+		desc  string
+		files map[string]string
+		err   error
+	}{
+		{"no version", map[string]string{"orders.go": imports + migrate("createOrders", "")}, errNoVersion},
+		{"two migrations", map[string]string{
+			"20240101120000_orders.go": imports + migrate("createOrders", "") + migrate("addIndex", ""),
+		}, errAmbiguous},
+		{"no function", map[string]string{"20240101120000_orders.go": imports}, errNoFunction},
+		{"duplicate file versions", map[string]string{
+			"20240101120000_orders.go":   imports + migrate("createOrders", ""),
+			"20240101120000_seed.up.sql": "",
+		}, errDuplicateVersion},
+		{"duplicate directive", map[string]string{
+			"20240101120000_orders.go": imports + migrate("createOrders", ""),
+			"refunds.go":               imports + migrate("createRefunds", "//gofr:migration 20240101120000\n"),
+		}, errDuplicateVersion},
+		{"invalid directive", map[string]string{
+			"refunds.go": imports + migrate("createRefunds", "//gofr:migration next\n"),
+		}, errDirective},
+	}
+
+	for i, tc := range tests {
+		dir := t.TempDir()
+		writeFiles(t, dir, tc.files)
+
+		_, err := findMigrations(dir)
+
+		assert.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestFuncName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"add_orders", "add_orders"},
+		{"add-orders", "add_orders"},
+		{"addOrders", "addOrders"},
+		{"2fa codes", "_2fa_codes"},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.want, funcName(tc.name), "TEST[%d], Failed.\n%s", i, tc.name)
+	}
+}
@@ -1,9 +1,13 @@
 package migration
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"go/format"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"text/template"
 	"time"
@@ -14,10 +18,18 @@ import (
 const (
 	mig     = "migrations"
 	allFile = "all.go"
+
+	versionLayout = "20060102150405"
+
+	// sqlSuffix is the suffix of the migrations written in plain SQL.
+	sqlSuffix = ".up.sql"
 )
 
 var (
 	errNameEmpty = errors.New(`please provide the name of the migration using "-name" option`)
+	errType      = errors.New("invalid migration type, supported types are sql, redis, mongo, cassandra, clickhouse and pubsub")
+	errFormat    = errors.New("invalid migration format, supported formats are go and sql")
+	errSQLType   = errors.New("migrations in the sql format can only be of the sql type")
 )
 
 //nolint:gochecknoglobals // keeping them local so that they are computed at the compile time.
@@ -27,15 +39,165 @@ var (
 package migrations
 
 import (
+{{- if .SQL }}
+	"embed"
+	"strings"
+{{ end }}
 	"gofr.dev/pkg/gofr/migration"
 )
+{{- if .SQL }}
+
+//go:embed *` + sqlSuffix + `
+var sqlFiles embed.FS
+{{- end }}
 
 func All() map[int64]migration.Migrate {
-	return map[int64]migration.Migrate {
-{{range $key, $value := .}}	
-		{{ $key }}: {{ $value }}(),{{end}}
+	return map[int64]migration.Migrate{
+{{- range .Migrations }}
+		{{ .Version }}: {{ if .SQL }}sqlFileMigration({{ printf "%q" .SQL }}){{ else }}{{ .Func }}(){{ end }},
+{{- end }}
 	}
 }
+{{- if .SQL }}
+
+// sqlFileMigration runs the statements of the embedded SQL file one after the other.
+func sqlFileMigration(file string) migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			content, err := sqlFiles.ReadFile(file)
+			if err != nil {
+				return err
+			}
+
+			for _, statement := range sqlStatements(string(content)) {
+				_, err = d.SQL.Exec(statement)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// sqlStatements splits the SQL script at the semicolons outside of quotes, comments and PostgreSQL dollar quoted
+// strings, e.g. the bodies of functions between $$ or $body$.
+func sqlStatements(script string) []string {
+	var (
+		statements []string
+		current    strings.Builder
+		quote      byte
+		dollar     string
+		comment    bool
+		block      bool
+	)
+
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+
+		switch {
+		case comment:
+			if c != '\n' {
+				continue
+			}
+
+			// the line break ending the comment separates the words around it.
+			comment = false
+		case block:
+			if strings.HasPrefix(script[i:], "*/") {
+				block = false
+				i++
+			}
+
+			continue
+		case dollar != "":
+			if strings.HasPrefix(script[i:], dollar) {
+				current.WriteString(dollar)
+				i += len(dollar) - 1
+				dollar = ""
+
+				continue
+			}
+		case quote != 0:
+			switch {
+			case c == '\\' && quote != '` + "`" + `' && i+1 < len(script):
+				// MySQL escapes the quote with a backslash in strings, e.g. 'it\'s'.
+				current.WriteByte(c)
+				i++
+				c = script[i]
+			case c == quote:
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '` + "`" + `':
+			quote = c
+		case strings.HasPrefix(script[i:], "--"):
+			comment = true
+
+			continue
+		case strings.HasPrefix(script[i:], "/*"):
+			// the comment separates the words around it.
+			current.WriteByte(' ')
+
+			block = true
+			i++
+
+			continue
+		case c == '$':
+			if dollar = dollarTag(script[i:]); dollar != "" {
+				current.WriteString(dollar)
+				i += len(dollar) - 1
+
+				continue
+			}
+		case c == ';':
+			if statement := strings.TrimSpace(current.String()); statement != "" {
+				statements = append(statements, statement)
+			}
+
+			current.Reset()
+
+			continue
+		}
+
+		current.WriteByte(c)
+	}
+
+	if statement := strings.TrimSpace(current.String()); statement != "" {
+		statements = append(statements, statement)
+	}
+
+	return statements
+}
+
+// dollarTag returns the tag opening a dollar quoted string at the start of s, e.g. $$ or $body$, empty when
+// s starts with something else like the parameter $1.
+func dollarTag(s string) string {
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == '$':
+			return s[:i+1]
+		case c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || i > 1 && '0' <= c && c <= '9':
+		default:
+			return ""
+		}
+	}
+
+	return ""
+}
+{{- end }}
+`))
+
+	sqlTemplate = template.Must(template.New("sqlContent").Parse(
+		`-- Migration {{ .Name }}, embedded in all.go and applied to the SQL datasource.
+-- Statements are separated by semicolons and run one after the other, e.g.
+--
+-- CREATE TABLE IF NOT EXISTS orders (
+--     id       INT PRIMARY KEY,
+--     customer VARCHAR(255) NOT NULL
+-- );
 `))
 
 	migrationTemplate = template.Must(template.New("migrationContent").
@@ -43,39 +205,118 @@ func All() map[int64]migration.Migrate {
 			`package migrations
 
 import (
+{{- if .Context }}
+	"context"
+{{ end }}
 	"gofr.dev/pkg/gofr/migration"
 )
 
-func {{ . }}() migration.Migrate {
+func {{ .Name }}() migration.Migrate {
 	return migration.Migrate{
 		UP: func(d migration.Datasource) error {
+{{- if .Up }}
+			{{ .Up }}
+{{- else }}
 			// write your migrations here
 
 			return nil
+{{- end }}
 		},
 	}
 }
 `))
 )
 
+// skeleton is the example body of the UP function of a migration of a type.
+type skeleton struct {
+	// context reports whether the body needs the context package.
+	context bool
+	up      string
+}
+
+//nolint:gochecknoglobals // examples of the migrations of each -type.
+var skeletons = map[string]skeleton{
+	"sql": {up: "_, err := d.SQL.Exec(`CREATE TABLE IF NOT EXISTS orders (\n" +
+		"\tid         INT PRIMARY KEY,\n" +
+		"\tcustomer   VARCHAR(255) NOT NULL,\n" +
+		"\tcreated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP\n" +
+		")`)\n" +
+		"if err != nil {\n" +
+		"return err\n" +
+		"}\n\n" +
+		"_, err = d.SQL.Exec(\"CREATE INDEX idx_orders_customer ON orders (customer)\")\n\n" +
+		"return err"},
+	"redis": {context: true, up: "// keys set without an expiration are kept until they are deleted.\n" +
+		"return d.Redis.Set(context.Background(), \"orders:page_size\", 20, 0).Err()"},
+	"mongo": {context: true, up: "ctx := context.Background()\n\n" +
+		"err := d.Mongo.CreateCollection(ctx, \"orders\")\n" +
+		"if err != nil {\n" +
+		"return err\n" +
+		"}\n\n" +
+		"_, err = d.Mongo.InsertOne(ctx, \"settings\", map[string]any{\"name\": \"orders_page_size\", \"value\": 20})\n\n" +
+		"return err"},
+	"cassandra": {up: "return d.Cassandra.Exec(`CREATE TABLE IF NOT EXISTS orders (\n" +
+		"\tid         uuid PRIMARY KEY,\n" +
+		"\tcustomer   text,\n" +
+		"\tcreated_at timestamp\n" +
+		")`)"},
+	"clickhouse": {context: true, up: "return d.Clickhouse.Exec(context.Background(), `CREATE TABLE IF NOT EXISTS orders (\n" +
+		"\tid         UInt64,\n" +
+		"\tcustomer   String,\n" +
+		"\tcreated_at DateTime\n" +
+		") ENGINE = MergeTree ORDER BY id`)"},
+	"pubsub": {context: true, up: "return d.PubSub.CreateTopic(context.Background(), \"orders\")"},
+}
+
+// Migration is a migration to create.
+type Migration struct {
+	// Name is the name of the migration, which is the name of its function as well once the characters which
+	// are not allowed in identifiers, e.g. hyphens, are replaced by underscores.
+	Name string
+	// Up is the body of the UP function, without it the function only returns nil.
+	Up string
+	// Type is the datasource the migration changes, one of the keys of skeletons. Migrations of a type without
+	// Up get an example body using the datasource.
+	Type string
+	// Format is go, the default, or sql for a migration written in plain SQL, which all.go embeds and runs.
+	Format string
+}
+
+// Migrate creates a migration in the migrations directory and registers it in all.go, e.g.
+//
+//	gofr migrate create -name=add_orders -type=sql
+//
+// -type is one of sql, redis, mongo, cassandra, clickhouse and pubsub, it makes the migration an example using the
+// datasource instead of an empty one. With -format=sql the migration is a .up.sql file for the SQL datasource.
 func Migrate(ctx *gofr.Context) (interface{}, error) {
 	migName := ctx.Param("name")
 	if migName == "" {
 		return nil, errNameEmpty
 	}
 
-	if err := createMigrationFile(ctx, migName); err != nil {
+	fileName, content, all, err := Generate(mig, Migration{
+		Name:   migName,
+		Type:   strings.ToLower(ctx.Param("type")),
+		Format: strings.ToLower(ctx.Param("format")),
+	}, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = createMigrationFile(ctx, fileName, content)
+	if err != nil {
 		return nil, fmt.Errorf("error while creating migration file, err: %w", err)
 	}
 
-	if err := createAllMigration(ctx); err != nil {
+	err = createAllMigration(ctx, all)
+	if err != nil {
 		return nil, fmt.Errorf("error while creating all.go file, err: %w", err)
 	}
 
 	return fmt.Sprintf("Successfully created migration %v", migName), nil
 }
 
-func createMigrationFile(ctx *gofr.Context, migrationName string) error {
+func createMigrationFile(ctx *gofr.Context, fileName string, content []byte) error {
 	if _, err := os.Stat(mig); os.IsNotExist(err) {
 		er := ctx.File.MkdirAll(mig, os.ModePerm)
 		if er != nil {
@@ -83,61 +324,161 @@ func createMigrationFile(ctx *gofr.Context, migrationName string) error {
 		}
 	}
 
-	if err := os.Chdir(mig); err != nil {
+	file, err := ctx.File.OpenFile(filepath.Join(mig, fileName), os.O_CREATE|os.O_WRONLY, os.ModePerm)
+	if err != nil {
 		return err
 	}
 
-	currTimeStamp := time.Now().Format("20060102150405")
+	defer file.Close()
+
+	_, err = file.Write(content)
 
-	fileName := currTimeStamp + "_" + migrationName
+	return err
+}
 
-	file, err := ctx.File.OpenFile(fileName+".go", os.O_CREATE|os.O_WRONLY, os.ModePerm)
+func createAllMigration(ctx *gofr.Context, content []byte) error {
+	f, err := ctx.File.Create(filepath.Join(mig, allFile))
 	if err != nil {
 		return err
 	}
 
-	defer file.Close()
+	defer f.Close()
+
+	_, err = f.Write(content)
 
-	err = migrationTemplate.Execute(file, migrationName)
+	return err
+}
+
+// Generate renders the migration m with the version taken from createdAt, and the all.go of the migrations
+// directory dir registering it next to the migrations which exist in dir already. The directory does not
+// have to exist. It returns the name of the migration file, relative to dir, and the content of both files.
+func Generate(dir string, m Migration, createdAt time.Time) (fileName string, content, all []byte, err error) {
+	existing, err := findMigrations(dir)
 	if err != nil {
-		return err
+		return "", nil, nil, err
+	}
+
+	// versions have a precision of a second, a migration created in the same second as another one comes after it.
+	for existing[Version(createdAt)] != "" {
+		createdAt = createdAt.Add(time.Second)
+	}
+
+	fileName, content, err = render(m, createdAt)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	existing[Version(createdAt)] = funcName(m.Name)
+	if strings.HasSuffix(fileName, sqlSuffix) {
+		existing[Version(createdAt)] = fileName
+	}
+
+	all, err = AllFile(existing)
+	if err != nil {
+		return "", nil, nil, err
 	}
 
-	return nil
+	return fileName, content, all, nil
+}
+
+// File renders the migration migrationName with the version taken from createdAt. It returns
+// the name of the migration file, relative to the migrations directory, and its content.
+func File(migrationName string, createdAt time.Time) (fileName string, content []byte, err error) {
+	return render(Migration{Name: migrationName}, createdAt)
 }
 
-func createAllMigration(ctx *gofr.Context) error {
-	f, err := ctx.File.Create(allFile)
+func render(m Migration, createdAt time.Time) (fileName string, content []byte, err error) {
+	switch m.Format {
+	case "", "go":
+	case "sql":
+		return renderSQL(m, createdAt)
+	default:
+		return "", nil, fmt.Errorf("%w, got %q", errFormat, m.Format)
+	}
+
+	data := struct {
+		Name    string
+		Up      string
+		Context bool
+	}{Name: funcName(m.Name), Up: m.Up}
+
+	if m.Type != "" {
+		s, ok := skeletons[m.Type]
+		if !ok {
+			return "", nil, fmt.Errorf("%w, got %q", errType, m.Type)
+		}
+
+		if data.Up == "" {
+			data.Up, data.Context = s.up, s.context
+		}
+	}
+
+	var buf bytes.Buffer
+
+	err = migrationTemplate.Execute(&buf, data)
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
-	d, err := os.ReadDir("./")
+	content, err = format.Source(buf.Bytes())
 	if err != nil {
-		return err
+		return "", nil, err
+	}
+
+	return Version(createdAt) + "_" + m.Name + ".go", content, nil
+}
+
+func renderSQL(m Migration, createdAt time.Time) (fileName string, content []byte, err error) {
+	if m.Type != "" && m.Type != "sql" {
+		return "", nil, fmt.Errorf("%w, got %q", errSQLType, m.Type)
 	}
 
-	migrations := findMigrations(d)
+	var buf bytes.Buffer
 
-	err = allTemplate.Execute(f, migrations)
+	err = sqlTemplate.Execute(&buf, m)
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
-	return nil
+	return Version(createdAt) + "_" + m.Name + sqlSuffix, buf.Bytes(), nil
 }
 
-func findMigrations(files []os.DirEntry) map[string]string {
-	var existingMig = make(map[string]string)
+// Version returns the version of a migration created at createdAt.
+func Version(createdAt time.Time) string {
+	return createdAt.Format(versionLayout)
+}
 
-	for _, file := range files {
-		fileParts := strings.Split(file.Name(), "_")
-		if len(fileParts) < 2 || file.Name() == allFile || fileParts[len(fileParts)-1] == "test.go" {
-			continue
+// AllFile renders the all.go file registering the migrations, which are keyed by their version with the name
+// of the migration function as value, or the name of the file for migrations written in plain SQL.
+func AllFile(migrations map[string]string) ([]byte, error) {
+	type entry struct {
+		Version, Func, SQL string
+	}
+
+	data := struct {
+		Migrations []entry
+		SQL        bool
+	}{}
+
+	for version, m := range migrations {
+		e := entry{Version: version, Func: m}
+
+		if strings.HasSuffix(m, sqlSuffix) {
+			e = entry{Version: version, SQL: m}
+			data.SQL = true
 		}
 
-		existingMig[fileParts[0]] = strings.TrimSuffix(strings.Join(fileParts[1:], "_"), ".go")
+		data.Migrations = append(data.Migrations, e)
+	}
+
+	sort.Slice(data.Migrations, func(i, j int) bool { return data.Migrations[i].Version < data.Migrations[j].Version })
+
+	var buf bytes.Buffer
+
+	err := allTemplate.Execute(&buf, data)
+	if err != nil {
+		return nil, err
 	}
 
-	return existingMig
+	return format.Source(buf.Bytes())
 }
@@ -0,0 +1,188 @@
+package migration
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		typ  string
+		call string
+	}{
+		{"", "// write your migrations here"},
+		{"sql", "d.SQL.Exec(`CREATE TABLE IF NOT EXISTS orders ("},
+		{"redis", `d.Redis.Set(context.Background(), "orders:page_size", 20, 0).Err()`},
+		{"mongo", `d.Mongo.CreateCollection(ctx, "orders")`},
+		{"cassandra", "d.Cassandra.Exec(`CREATE TABLE IF NOT EXISTS orders ("},
+		{"clickhouse", "d.Clickhouse.Exec(context.Background(), `CREATE TABLE IF NOT EXISTS orders ("},
+		{"pubsub", `d.PubSub.CreateTopic(context.Background(), "orders")`},
+	}
+
+	for i, tc := range tests {
+		fileName, content, err := render(Migration{Name: "addOrders", Type: tc.typ}, createdAt)
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.typ)
+
+		assert.Equal(t, "20240102150405_addOrders.go", fileName, "TEST[%d], Failed.\n%s", i, tc.typ)
+		assert.Contains(t, string(content), tc.call, "TEST[%d], Failed.\n%s", i, tc.typ)
+
+		// the skeletons have to compile once the migration is in a project, so they must at least parse.
+		_, err = parser.ParseFile(token.NewFileSet(), fileName, content, 0)
+		assert.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.typ)
+	}
+}
+
+func TestRender_Up(t *testing.T) {
+	_, content, err := render(Migration{Name: "createOrders", Up: "return nil // custom", Type: "sql"}, time.Now())
+	require.NoError(t, err)
+
+	assert.Contains(t, string(content), "return nil // custom")
+	assert.NotContains(t, string(content), "d.SQL.Exec")
+}
+
+func TestRender_UnknownType(t *testing.T) {
+	_, _, err := render(Migration{Name: "addOrders", Type: "mysql"}, time.Now())
+
+	assert.ErrorIs(t, err, errType)
+}
+
+func TestRender_SQL(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	fileName, content, err := render(Migration{Name: "add_orders", Format: "sql"}, createdAt)
+	require.NoError(t, err)
+
+	assert.Equal(t, "20240102150405_add_orders.up.sql", fileName)
+	assert.Contains(t, string(content), "-- Migration add_orders")
+
+	_, _, err = render(Migration{Name: "add_orders", Format: "sql", Type: "redis"}, createdAt)
+	require.ErrorIs(t, err, errSQLType)
+
+	_, _, err = render(Migration{Name: "add_orders", Format: "yaml"}, createdAt)
+	require.ErrorIs(t, err, errFormat)
+}
+
+func TestGenerate_SQL(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFiles(t, dir, map[string]string{
+		"20240101120000_createOrders.go": migrationSource("createOrders", "CREATE TABLE orders (id INTEGER)"),
+		"20240101130000_seed.up.sql":     "INSERT INTO orders VALUES (1);\n",
+		"all.go":                         "package migrations\n",
+		"README.md":                      "# Migrations\n",
+	})
+
+	_, _, all, err := Generate(dir, Migration{Name: "add_index", Format: "sql"}, time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC))
+	require.NoError(t, err)
+
+	_, err = parser.ParseFile(token.NewFileSet(), allFile, all, 0)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(all), "//go:embed *.up.sql\nvar sqlFiles embed.FS")
+	assert.Contains(t, string(all), "\t\t20240101120000: createOrders(),\n"+
+		"\t\t20240101130000: sqlFileMigration(\"20240101130000_seed.up.sql\"),\n"+
+		"\t\t20240102150405: sqlFileMigration(\"20240102150405_add_index.up.sql\"),\n")
+}
+
+func TestAllFile_Go(t *testing.T) {
+	all, err := AllFile(map[string]string{"20240101120000": "initial"})
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(all), "embed")
+	assert.Contains(t, string(all), "\t\t20240101120000: initial(),\n")
+}
+
+// TestSQLStatements runs the splitter of the generated all.go, which only needs the standard library to build.
+func TestSQLStatements(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the generated splitter")
+	}
+
+	tests := []struct {
+		script string
+		want   []string
+	}{
+		{"CREATE TABLE orders (id INT);\nINSERT INTO orders VALUES (1);\n",
+			[]string{"CREATE TABLE orders (id INT)", "INSERT INTO orders VALUES (1)"}},
+		{"INSERT INTO notes VALUES ('a;b', \"c;d\", 'it''s;');", []string{"INSERT INTO notes VALUES ('a;b', \"c;d\", 'it''s;')"}},
+		{"-- first; statement\nSELECT 1;", []string{"SELECT 1"}},
+		{"SELECT a -- the column\nFROM t;", []string{"SELECT a \nFROM t"}},
+		{"INSERT INTO notes VALUES ('it\\'s;', \"a\\\\\", 'b');", []string{"INSERT INTO notes VALUES ('it\\'s;', \"a\\\\\", 'b')"}},
+		{"/* drop; the\n orders */ SELECT/* ; */1;", []string{"SELECT 1"}},
+		{"SELECT '/* not; a comment */';", []string{"SELECT '/* not; a comment */'"}},
+		{"CREATE FUNCTION one() RETURNS INT AS $$ SELECT 1; $$ LANGUAGE SQL;\nSELECT one();",
+			[]string{"CREATE FUNCTION one() RETURNS INT AS $$ SELECT 1; $$ LANGUAGE SQL", "SELECT one()"}},
+		{"DO $body$ BEGIN PERFORM 1; PERFORM '$$'; END $body$;", []string{"DO $body$ BEGIN PERFORM 1; PERFORM '$$'; END $body$"}},
+		{"SELECT $1, $2;", []string{"SELECT $1, $2"}},
+		{";\n\n;", nil},
+	}
+
+	scripts := make([]string, 0, len(tests))
+	for _, tc := range tests {
+		scripts = append(scripts, tc.script)
+	}
+
+	got := runSplitter(t, scripts)
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.want, got[i], "TEST[%d], Failed.\n%s", i, tc.script)
+	}
+}
+
+// runSplitter builds the functions of the generated all.go splitting SQL scripts into a program printing the
+// statements of the scripts.
+func runSplitter(t *testing.T, scripts []string) [][]string {
+	t.Helper()
+
+	all, err := AllFile(map[string]string{"20240101120000": "20240101120000_seed.up.sql"})
+	require.NoError(t, err)
+
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, allFile, all, parser.ParseComments)
+	require.NoError(t, err)
+
+	program := bytes.NewBufferString("package main\n\nimport (\n\t\"encoding/json\"\n\t\"os\"\n\t\"strings\"\n)\n\n" +
+		"func main() {\n\tvar scripts []string\n\n\t_ = json.NewDecoder(os.Stdin).Decode(&scripts)\n\n" +
+		"\tstatements := make([][]string, 0, len(scripts))\n\tfor _, s := range scripts {\n" +
+		"\t\tstatements = append(statements, sqlStatements(s))\n\t}\n\n\t_ = json.NewEncoder(os.Stdout).Encode(statements)\n}\n")
+
+	for _, d := range f.Decls {
+		if fn, ok := d.(*ast.FuncDecl); ok && (fn.Name.Name == "sqlStatements" || fn.Name.Name == "dollarTag") {
+			program.WriteString("\n")
+			require.NoError(t, printer.Fprint(program, fset, fn))
+			program.WriteString("\n")
+		}
+	}
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"go.mod": "module splitter\n\ngo 1.22\n", "main.go": program.String()})
+
+	input, err := json.Marshal(scripts)
+	require.NoError(t, err)
+
+	cmd := exec.Command("go", "run", ".")
+	cmd.Dir = dir
+	cmd.Stdin = bytes.NewReader(input)
+
+	out, err := cmd.Output()
+	require.NoError(t, err, "%s", program)
+
+	var statements [][]string
+
+	require.NoError(t, json.Unmarshal(out, &statements))
+
+	return statements
+}
@@ -0,0 +1,358 @@
+package migration
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+)
+
+const (
+	stateApplied = "applied"
+	statePending = "pending"
+	// stateMissing is the state of the migrations which were applied, but are not in the migrations directory.
+	stateMissing = "missing"
+)
+
+var (
+	errOutputFormat = errors.New("invalid format, supported formats are table and json")
+	errStatus       = errors.New("reading the applied migrations failed")
+)
+
+//nolint:gochecknoglobals // keeping it local so that it is computed at the compile time.
+var statusTemplate = template.Must(template.New("statusContent").Parse(
+	`// This is auto-generated file using 'gofr migrate status' tool, it is removed once the status is read.
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"os"
+	"strconv"
+
+	"gofr.dev/pkg/gofr"
+)
+
+const migrationTable = "gofr_migrations"
+
+// tableQueries count the migration tables of the database, by dialect. The one of information_schema is used for
+// the dialects which are not listed.
+var tableQueries = map[string]string{
+	"sqlite": "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = '" + migrationTable + "'",
+	"mysql": "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = '" +
+		migrationTable + "'",
+	"": "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = '" +
+		migrationTable + "'",
+}
+
+type record struct {
+	Version   int64  ` + "`json:\"version\"`" + `
+	Method    string ` + "`json:\"method\"`" + `
+	StartTime string ` + "`json:\"startTime\"`" + `
+	Duration  int64  ` + "`json:\"duration\"`" + `
+}
+
+func main() {
+	app := gofr.NewCMD()
+
+	app.SubCommand("status", status)
+
+	app.Run()
+}
+
+// status writes the migrations recorded by GoFr in the SQL datasource and in Redis to the output file.
+func status(ctx *gofr.Context) (any, error) {
+	records := make([]record, 0)
+
+	if dialect := ctx.Config.Get("DB_DIALECT"); dialect != "" {
+		query, ok := tableQueries[dialect]
+		if !ok {
+			query = tableQueries[""]
+		}
+
+		var tables int
+
+		// the table does not exist before the first migration ran, reading it is the same for every dialect.
+		err := ctx.SQL.QueryRowContext(ctx, query).Scan(&tables)
+		if err != nil {
+			return nil, err
+		}
+
+		if tables > 0 {
+			records, err = sqlRecords(ctx, records)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if ctx.Config.Get("REDIS_HOST") != "" {
+		hash, err := ctx.Redis.HGetAll(ctx, migrationTable).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		for version, value := range hash {
+			var r record
+
+			err = json.Unmarshal([]byte(value), &r)
+			if err != nil {
+				return nil, err
+			}
+
+			r.Version, err = strconv.ParseInt(version, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+
+			records = append(records, r)
+		}
+	}
+
+	content, err := json.Marshal(records)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, os.WriteFile({{ printf "%q" .Output }}, content, 0600)
+}
+
+func sqlRecords(ctx *gofr.Context, records []record) ([]record, error) {
+	rows, err := ctx.SQL.QueryContext(ctx, "SELECT version, method, start_time, duration FROM "+migrationTable)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			r        record
+			duration sql.NullInt64
+		)
+
+		err = rows.Scan(&r.Version, &r.Method, &r.StartTime, &duration)
+		if err != nil {
+			return nil, err
+		}
+
+		r.Duration = duration.Int64
+		records = append(records, r)
+	}
+
+	return records, rows.Err()
+}
+`))
+
+// record is a migration GoFr recorded as run in the gofr_migrations table, or hash for Redis.
+type record struct {
+	Version   int64  `json:"version"`
+	Method    string `json:"method"`
+	StartTime string `json:"startTime"`
+	// Duration is in milliseconds.
+	Duration int64 `json:"duration"`
+}
+
+// migrationStatus is the state of a migration, in the migrations directory or applied to the datasources.
+type migrationStatus struct {
+	Version int64 `json:"version"`
+	// Name is the name of the migration function, or of the file of migrations written in plain SQL.
+	Name string `json:"name"`
+	// AppliedAt is the time the migration started to run, in RFC 3339 when it could be parsed.
+	AppliedAt string `json:"applied_at,omitempty"`
+	// DurationMS is the time the migration took to run, in milliseconds.
+	DurationMS int64 `json:"duration_ms,omitempty"`
+	// State is applied, pending or missing for migrations which were applied, but are not on disk.
+	State string `json:"state"`
+}
+
+// Status compares the migrations GoFr recorded as applied in the gofr_migrations table, or hash for Redis, with
+// the ones in the migrations directory, e.g.
+//
+//	gofr migrate status -env=staging -format=json
+//
+// It prints the version, name, time applied, duration and state of each migration, as a table or as JSON with
+// -format=json. The SQL datasources and Redis are supported, they are read from configs/.env like migrate up does.
+func Status(ctx *gofr.Context) (any, error) {
+	format := ctx.Param("format")
+	if format != "" && format != "table" && format != "json" {
+		return nil, fmt.Errorf("%w, got %q", errOutputFormat, format)
+	}
+
+	migrations, err := status(".", ctx.Param("env"), os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == "json" {
+		out, jsonErr := json.MarshalIndent(migrations, "", "  ")
+		if jsonErr != nil {
+			return nil, jsonErr
+		}
+
+		return string(out), nil
+	}
+
+	return statusTable(migrations), nil
+}
+
+// status returns the statuses of the migrations of the project in dir, the logs of the runner reading the
+// applied ones are written to logs when it fails.
+func status(dir, env string, logs io.Writer) ([]migrationStatus, error) {
+	_, err := modulePath(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	onDisk, err := findMigrations(filepath.Join(dir, mig))
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := appliedMigrations(dir, env, logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses(applied, onDisk), nil
+}
+
+// appliedMigrations reads the migrations recorded as run with a program built in the project.
+func appliedMigrations(dir, env string, logs io.Writer) ([]record, error) {
+	output, err := os.CreateTemp("", "gofr-migrate-status-*.json")
+	if err != nil {
+		return nil, err
+	}
+
+	output.Close()
+
+	defer os.Remove(output.Name())
+
+	var program bytes.Buffer
+
+	err = statusTemplate.Execute(&program, struct{ Output string }{output.Name()})
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+
+	err = run(dir, env, program.Bytes(), &out, &out, "status")
+
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return nil, err
+	}
+
+	content, readErr := os.ReadFile(output.Name())
+	if err != nil || readErr != nil || len(content) == 0 {
+		_, _ = io.Copy(logs, &out)
+
+		return nil, fmt.Errorf("%w, see the logs above", errStatus)
+	}
+
+	var records []record
+
+	err = json.Unmarshal(content, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// statuses merges the migrations recorded as applied with the ones on disk, ordered by version.
+func statuses(applied []record, onDisk map[string]string) []migrationStatus {
+	byVersion := make(map[int64]*migrationStatus)
+
+	for version, name := range onDisk {
+		v, err := strconv.ParseInt(version, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		byVersion[v] = &migrationStatus{Version: v, Name: name, State: statePending}
+	}
+
+	for _, r := range applied {
+		if r.Method != "UP" {
+			continue
+		}
+
+		s, ok := byVersion[r.Version]
+		if !ok {
+			s = &migrationStatus{Version: r.Version, State: stateMissing}
+			byVersion[r.Version] = s
+		} else if s.State == statePending {
+			s.State = stateApplied
+		}
+
+		// a migration is recorded by every datasource it ran in, the first record is kept.
+		if s.AppliedAt == "" {
+			s.AppliedAt, s.DurationMS = appliedAt(r.StartTime), r.Duration
+		}
+	}
+
+	result := make([]migrationStatus, 0, len(byVersion))
+
+	for _, s := range byVersion {
+		result = append(result, *s)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+
+	return result
+}
+
+// appliedAt returns the start time of a migration in RFC 3339, SQL drivers and Redis store it in different formats.
+func appliedAt(start string) string {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999-07:00", "2006-01-02 15:04:05.999999999"} {
+		if t, err := time.Parse(layout, start); err == nil {
+			return t.Format(time.RFC3339)
+		}
+	}
+
+	return start
+}
+
+func statusTable(statuses []migrationStatus) string {
+	if len(statuses) == 0 {
+		return "No migrations found"
+	}
+
+	var buf bytes.Buffer
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT\tDURATION\tSTATE")
+
+	for _, s := range statuses {
+		applied, duration, state := "-", "-", s.State
+
+		if s.AppliedAt != "" {
+			applied, duration = s.AppliedAt, (time.Duration(s.DurationMS) * time.Millisecond).String()
+		}
+
+		if state == stateMissing {
+			state = "missing on disk"
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", s.Version, s.Name, applied, duration, state)
+	}
+
+	w.Flush()
+
+	return strings.TrimRight(buf.String(), "\n")
+}
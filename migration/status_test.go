@@ -0,0 +1,101 @@
+package migration
+
+import (
+	"bytes"
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatuses(t *testing.T) {
+	onDisk := map[string]string{
+		"20240101120000": "createOrders",
+		"20240102120000": "20240102120000_seed.up.sql",
+		"20240103120000": "addIndex",
+	}
+
+	applied := []record{
+		{Version: 20240101120000, Method: "UP", StartTime: "2024-05-01T10:00:00.123456Z", Duration: 12},
+		// the same migration recorded by another datasource.
+		{Version: 20240101120000, Method: "UP", StartTime: "2024-05-01 10:00:05", Duration: 3},
+		{Version: 20231201120000, Method: "UP", StartTime: "2024-04-01 09:00:00.5+02:00", Duration: 1500},
+		{Version: 20240103120000, Method: "DOWN", StartTime: "2024-05-01T10:00:00Z"},
+	}
+
+	assert.Equal(t, []migrationStatus{
+		{Version: 20231201120000, AppliedAt: "2024-04-01T09:00:00+02:00", DurationMS: 1500, State: stateMissing},
+		{Version: 20240101120000, Name: "createOrders", AppliedAt: "2024-05-01T10:00:00Z", DurationMS: 12, State: stateApplied},
+		{Version: 20240102120000, Name: "20240102120000_seed.up.sql", State: statePending},
+		{Version: 20240103120000, Name: "addIndex", State: statePending},
+	}, statuses(applied, onDisk))
+}
+
+func TestAppliedAt(t *testing.T) {
+	tests := []struct {
+		start string
+		want  string
+	}{
+		{"2024-05-01T10:00:00.123456789Z", "2024-05-01T10:00:00Z"},
+		{"2024-05-01 10:00:00.123+05:30", "2024-05-01T10:00:00+05:30"},
+		{"2024-05-01 10:00:00", "2024-05-01T10:00:00Z"},
+		{"yesterday", "yesterday"},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.want, appliedAt(tc.start), "TEST[%d], Failed.\n%s", i, tc.start)
+	}
+}
+
+func TestStatusTable(t *testing.T) {
+	migrations := []migrationStatus{
+		{Version: 20231201120000, AppliedAt: "2024-04-01T09:00:00Z", DurationMS: 1500, State: stateMissing},
+		{Version: 20240101120000, Name: "createOrders", AppliedAt: "2024-05-01T10:00:00Z", DurationMS: 12, State: stateApplied},
+		{Version: 20240102120000, Name: "addIndex", State: statePending},
+	}
+
+	assert.Equal(t, "VERSION         NAME          APPLIED AT            DURATION  STATE\n"+
+		"20231201120000                2024-04-01T09:00:00Z  1.5s      missing on disk\n"+
+		"20240101120000  createOrders  2024-05-01T10:00:00Z  12ms      applied\n"+
+		"20240102120000  addIndex      -                     -         pending", statusTable(migrations))
+	assert.Equal(t, "No migrations found", statusTable(nil))
+}
+
+func TestStatusTemplate(t *testing.T) {
+	var program bytes.Buffer
+
+	require.NoError(t, statusTemplate.Execute(&program, struct{ Output string }{"/tmp/status.json"}))
+
+	formatted, err := format.Source(program.Bytes())
+	require.NoError(t, err)
+
+	// every SQL dialect is read, only the check for the migration table depends on it.
+	assert.Equal(t, program.String(), string(formatted))
+	assert.Contains(t, program.String(), `"sqlite": "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table'`)
+	assert.Contains(t, program.String(), `"mysql": "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE()`)
+	assert.Contains(t, program.String(), `"": "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema()`)
+}
+
+func TestStatus_NotAModule(t *testing.T) {
+	_, err := status(t.TempDir(), "", nil)
+
+	assert.ErrorIs(t, err, errNotAModule)
+}
+
+// TestStatus reads the migrations applied to SQLite, see TestUp.
+func TestStatus(t *testing.T) {
+	dir, _ := sqliteProject(t)
+
+	var out bytes.Buffer
+
+	require.NoError(t, up(dir, "staging", 20240101120000, &out, &out), out.String())
+
+	migrations, err := status(dir, "staging", &out)
+	require.NoError(t, err, out.String())
+	require.Len(t, migrations, 2)
+
+	assert.Equal(t, stateApplied, migrations[0].State)
+	assert.NotEmpty(t, migrations[0].AppliedAt)
+	assert.Equal(t, migrationStatus{Version: 20240102120000, Name: "createRefunds", State: statePending}, migrations[1])
+}
@@ -0,0 +1,214 @@
+package migration
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"text/template"
+
+	"golang.org/x/mod/modfile"
+
+	"gofr.dev/pkg/gofr"
+)
+
+const runnerPattern = "gofr-migrate-"
+
+var (
+	errNoMigrations = errors.New("no migrations found, please create one with gofr migrate create")
+	errNotAModule   = errors.New("go.mod not found, please run the command from the root of a GoFr project")
+	errEnvFile      = errors.New("configuration of the environment not found")
+	errTo           = errors.New(`invalid "-to" option, it has to be the version of a migration, e.g. 20240101120000`)
+	errUp           = errors.New("running the migrations failed")
+)
+
+//nolint:gochecknoglobals // keeping it local so that it is computed at the compile time.
+var runnerTemplate = template.Must(template.New("runnerContent").Parse(
+	`// This is auto-generated file using 'gofr migrate up' tool, it is removed once the migrations ran.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/migration"
+
+	"{{ .Module }}/` + mig + `"
+)
+
+func main() {
+	all := ` + mig + `.All()
+{{- if .To }}
+
+	for version := range all {
+		if version > {{ .To }} {
+			delete(all, version)
+		}
+	}
+{{- end }}
+
+	// GoFr logs the error of a failed migration and goes on, the runner exits with 1 for it instead.
+	failed := false
+
+	for version, m := range all {
+		if m.UP == nil {
+			continue
+		}
+
+		up := m.UP
+		m.UP = func(d migration.Datasource) error {
+			err := up(d)
+			if err != nil {
+				failed = true
+			}
+
+			return err
+		}
+
+		all[version] = m
+	}
+
+	app := gofr.New()
+
+	// GoFr logs that no datasource is initialized and migrates nothing, e.g. when DB_HOST is missing from the
+	// configs of the environment, the runner exits with 1 for it instead.
+	dialect := app.Config.Get("DB_DIALECT")
+
+	switch {
+	case dialect != "" && dialect != "sqlite" && app.Config.Get("DB_HOST") == "":
+		fmt.Fprintf(os.Stderr, "DB_DIALECT is %s but DB_HOST is not set, the SQL datasource is not initialized\n", dialect)
+		os.Exit(1)
+	case dialect == "" && app.Config.Get("REDIS_HOST") == "" && app.Config.Get("PUBSUB_BACKEND") == "":
+		fmt.Fprintln(os.Stderr, "no datasource is configured, set DB_DIALECT, REDIS_HOST or PUBSUB_BACKEND")
+		os.Exit(1)
+	}
+
+	app.Migrate(all)
+
+	if failed {
+		os.Exit(1)
+	}
+}
+`))
+
+// Up applies the pending migrations of the migrations package to the datasources configured in configs/.env,
+// without starting the app, e.g.
+//
+//	gofr migrate up -env=staging -to=20240101120000
+//
+// -env loads configs/.<env>.env on top of configs/.env, like APP_ENV does for the app, and -to only applies the
+// migrations up to the version. The migrations run in a temporary program built next to the migrations package.
+func Up(ctx *gofr.Context) (any, error) {
+	var to int64
+
+	if value := ctx.Param("to"); value != "" {
+		var err error
+
+		to, err = strconv.ParseInt(value, 10, 64)
+		if err != nil || to <= 0 {
+			return nil, fmt.Errorf("%w, got %q", errTo, value)
+		}
+	}
+
+	err := up(".", ctx.Param("env"), to, os.Stdout, os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+
+	return "Successfully ran the migrations", nil
+}
+
+// up runs the migrations of the project in dir, with the output of the runner written to stdout and stderr.
+// It fails when a migration returns an error, GoFr then rolls back the SQL transaction of the migration.
+func up(dir, env string, to int64, stdout, stderr io.Writer) error {
+	module, err := modulePath(dir)
+	if err != nil {
+		return err
+	}
+
+	if _, err = os.Stat(filepath.Join(dir, mig, allFile)); err != nil {
+		return errNoMigrations
+	}
+
+	content, err := runnerFile(module, to)
+	if err != nil {
+		return err
+	}
+
+	err = run(dir, env, content, stdout, stderr)
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return fmt.Errorf("%w, see the logs above", errUp)
+	}
+
+	return err
+}
+
+func modulePath(dir string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", errNotAModule
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return modfile.ModulePath(data), nil
+}
+
+// run runs the program with the arguments in the project in dir with the configuration of the environment env,
+// so that it builds with the dependencies of the project and reads its configs directory.
+func run(dir, env string, program []byte, stdout, stderr io.Writer, args ...string) error {
+	if env != "" {
+		envFile := filepath.Join("configs", "."+env+".env")
+
+		if _, err := os.Stat(filepath.Join(dir, envFile)); err != nil {
+			return fmt.Errorf("%w: %s", errEnvFile, envFile)
+		}
+	}
+
+	runner, err := os.MkdirTemp(dir, runnerPattern)
+	if err != nil {
+		return err
+	}
+
+	defer os.RemoveAll(runner)
+
+	err = os.WriteFile(filepath.Join(runner, "main.go"), program, 0600)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command("go", append([]string{"run", "./" + filepath.Base(runner)}, args...)...) //nolint:gosec // runs the generated program.
+	cmd.Dir = dir
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	cmd.Env = os.Environ()
+
+	if env != "" {
+		cmd.Env = append(cmd.Env, "APP_ENV="+env)
+	}
+
+	return cmd.Run()
+}
+
+// runnerFile renders the program applying the migrations of the module, up to the version to unless it is 0.
+func runnerFile(module string, to int64) ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := runnerTemplate.Execute(&buf, struct {
+		Module string
+		To     int64
+	}{module, to})
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,201 @@
+package migration
+
+import (
+	"bytes"
+	"go/format"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunnerFile(t *testing.T) {
+	content, err := runnerFile("example.com/shop", 0)
+	require.NoError(t, err)
+
+	formatted, err := format.Source(content)
+	require.NoError(t, err)
+
+	assert.Equal(t, string(formatted), string(content))
+	assert.Contains(t, string(content), "\t\"example.com/shop/migrations\"\n")
+	assert.Contains(t, string(content), "\tapp.Migrate(all)\n")
+	assert.Contains(t, string(content), "\t\tos.Exit(1)\n")
+	assert.NotContains(t, string(content), "delete")
+
+	// the datasources are checked before migrating, GoFr migrates nothing without one.
+	assert.Contains(t, string(content), `case dialect != "" && dialect != "sqlite" && app.Config.Get("DB_HOST") == "":`)
+	assert.Contains(t, string(content), `case dialect == "" && app.Config.Get("REDIS_HOST") == "" && app.Config.Get("PUBSUB_BACKEND") == "":`)
+	assert.Less(t, strings.Index(string(content), "DB_HOST"), strings.Index(string(content), "app.Migrate(all)"))
+
+	content, err = runnerFile("example.com/shop", 20240101120000)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(content), "if version > 20240101120000 {")
+}
+
+func TestUp_Errors(t *testing.T) {
+	empty := t.TempDir()
+
+	project := t.TempDir()
+	writeFiles(t, project, map[string]string{
+		"go.mod":            "module example.com/shop\n",
+		"migrations/all.go": "package migrations\n",
+	})
+
+	tests := []struct {
+		dir string
+		env string
+		err error
+	}{
+		{empty, "", errNotAModule},
+		{filepath.Join(project, "migrations"), "", errNotAModule},
+		{project, "staging", errEnvFile},
+	}
+
+	for i, tc := range tests {
+		err := up(tc.dir, tc.env, 0, nil, nil)
+
+		assert.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.dir)
+	}
+
+	require.NoError(t, os.Remove(filepath.Join(project, "migrations", "all.go")))
+
+	assert.ErrorIs(t, up(project, "", 0, nil, nil), errNoMigrations)
+}
+
+// TestUp runs the migrations of a project against SQLite, it needs gofr.dev in the module cache as the
+// dependencies are not downloaded.
+func TestUp(t *testing.T) {
+	dir, db := sqliteProject(t)
+
+	var out bytes.Buffer
+
+	require.NoError(t, up(dir, "staging", 20240101120000, &out, &out), out.String())
+
+	content, err := os.ReadFile(db)
+	require.NoError(t, err, out.String())
+
+	// the schema of SQLite databases is stored as text, the migrations after -to are not applied.
+	assert.Contains(t, string(content), "CREATE TABLE orders")
+	assert.Contains(t, string(content), "gofr_migrations")
+	assert.NotContains(t, string(content), "CREATE TABLE refunds")
+	assert.NoFileExists(t, filepath.Join(dir, "local.db"))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	for _, e := range entries {
+		assert.NotContains(t, e.Name(), runnerPattern, "the runner is removed")
+	}
+}
+
+// TestUp_FailedMigration checks that a migration GoFr logs as failed fails up, see TestUp.
+func TestUp_FailedMigration(t *testing.T) {
+	dir, _ := sqliteProject(t)
+
+	writeFiles(t, dir, map[string]string{
+		"migrations/20240103120000_broken.go": migrationSource("broken", "CREATE TABLE"),
+		"migrations/all.go": "package migrations\n\nimport \"gofr.dev/pkg/gofr/migration\"\n\n" +
+			"func All() map[int64]migration.Migrate {\n\treturn map[int64]migration.Migrate{\n" +
+			"\t\t20240101120000: createOrders(),\n\t\t20240103120000: broken(),\n\t}\n}\n",
+	})
+
+	var out bytes.Buffer
+
+	err := up(dir, "staging", 0, &out, &out)
+
+	require.ErrorIs(t, err, errUp, out.String())
+}
+
+// TestUp_NoDatasource checks that up fails when the environment does not configure the host of the database,
+// see TestUp.
+func TestUp_NoDatasource(t *testing.T) {
+	dir, _ := sqliteProject(t)
+
+	writeFiles(t, dir, map[string]string{"configs/.staging.env": "DB_DIALECT=postgres\n"})
+
+	var out bytes.Buffer
+
+	err := up(dir, "staging", 0, &out, &out)
+
+	require.ErrorIs(t, err, errUp, out.String())
+	assert.Contains(t, out.String(), "DB_DIALECT is postgres but DB_HOST is not set")
+}
+
+// sqliteProject creates a project with two migrations of a SQLite database, which is shop.db for the staging
+// environment. The test is skipped when the project can not be built offline.
+func sqliteProject(t *testing.T) (dir, db string) {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("builds a GoFr program")
+	}
+
+	version := gofrVersion()
+	if version == "" {
+		t.Skip("the version of gofr.dev is not known")
+	}
+
+	dir = t.TempDir()
+	db = filepath.Join(dir, "shop.db")
+
+	writeFiles(t, dir, map[string]string{
+		"go.mod":               "module example.com/shop\n\ngo 1.22\n\nrequire gofr.dev " + version + "\n",
+		"main.go":              "package main\n\nimport _ \"gofr.dev/pkg/gofr\"\n\nfunc main() {}\n",
+		"configs/.env":         "APP_NAME=shop\nDB_DIALECT=sqlite\nDB_NAME=" + filepath.Join(dir, "local.db") + "\n",
+		"configs/.staging.env": "DB_NAME=" + db + "\n",
+		"migrations/20240101120000_createOrders.go":  migrationSource("createOrders", "CREATE TABLE orders (id INTEGER PRIMARY KEY)"),
+		"migrations/20240102120000_createRefunds.go": migrationSource("createRefunds", "CREATE TABLE refunds (id INTEGER PRIMARY KEY)"),
+		"migrations/all.go": "package migrations\n\nimport \"gofr.dev/pkg/gofr/migration\"\n\n" +
+			"func All() map[int64]migration.Migrate {\n\treturn map[int64]migration.Migrate{\n" +
+			"\t\t20240101120000: createOrders(),\n\t\t20240102120000: createRefunds(),\n\t}\n}\n",
+	})
+
+	tidy := exec.Command("go", "mod", "tidy")
+	tidy.Dir = dir
+	tidy.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+
+	if out, err := tidy.CombinedOutput(); err != nil {
+		t.Skipf("gofr.dev %s is not in the module cache: %s", version, out)
+	}
+
+	return dir, db
+}
+
+func gofrVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path == "gofr.dev" && dep.Replace == nil {
+			return dep.Version
+		}
+	}
+
+	return ""
+}
+
+func migrationSource(name, statement string) string {
+	return "package migrations\n\nimport \"gofr.dev/pkg/gofr/migration\"\n\n" +
+		"func " + name + "() migration.Migrate {\n\treturn migration.Migrate{\n" +
+		"\t\tUP: func(d migration.Datasource) error {\n" +
+		"\t\t\t_, err := d.SQL.Exec(\"" + statement + "\")\n\n\t\t\treturn err\n\t\t},\n\t}\n}\n"
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+}
@@ -0,0 +1,72 @@
+// Package modcache looks up the versions of modules in the local Go module cache, so that the go.mod
+// files the CLI writes can require versions which build without downloading anything.
+package modcache
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// Versions returns the released versions of mod which are available in the module cache at modCache.
+func Versions(modCache, mod string) []string {
+	if modCache == "" {
+		return nil
+	}
+
+	escaped, err := module.EscapePath(mod)
+	if err != nil {
+		return nil
+	}
+
+	var versions []string
+
+	// extracted modules live in <module>@<version>, downloaded ones in cache/download/<module>/@v/<version>.zip.
+	extracted, _ := filepath.Glob(filepath.Join(modCache, filepath.FromSlash(escaped)+"@v*"))
+	for _, dir := range extracted {
+		versions = append(versions, dir[strings.LastIndex(dir, "@")+1:])
+	}
+
+	downloaded, _ := filepath.Glob(filepath.Join(modCache, "cache", "download", filepath.FromSlash(escaped), "@v", "v*.zip"))
+	for _, zip := range downloaded {
+		versions = append(versions, strings.TrimSuffix(filepath.Base(zip), ".zip"))
+	}
+
+	released := versions[:0]
+
+	for _, v := range versions {
+		if semver.IsValid(v) && semver.Prerelease(v) == "" && semver.Build(v) == "" {
+			released = append(released, v)
+		}
+	}
+
+	return released
+}
+
+// Latest returns the newest released version of mod in the module cache of the Go toolchain, or an empty
+// string when the cache has none.
+func Latest(mod string) string {
+	versions := Versions(GoEnv("GOMODCACHE"), mod)
+	if len(versions) == 0 {
+		return ""
+	}
+
+	semver.Sort(versions)
+
+	return versions[len(versions)-1]
+}
+
+// GoEnv returns the value of the go env variable key, reading it from the environment when
+// the go command is not available.
+func GoEnv(key string) string {
+	out, err := exec.Command("go", "env", key).Output() //nolint:gosec // key is a go env variable name chosen by the CLI.
+	if err != nil {
+		return os.Getenv(key)
+	}
+
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,27 @@
+package modcache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersions(t *testing.T) {
+	modCache := t.TempDir()
+
+	for _, dir := range []string{"gofr.dev@v1.28.0", "gofr.dev@v1.9.2", "gofr.dev@v1.40.0-rc.1", "cache/download/gofr.dev/@v",
+		"github.com/!burnt!sushi/toml@v1.4.0"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(modCache, dir), 0755))
+	}
+
+	for _, f := range []string{"v1.31.0.zip", "v1.31.0.mod", "v1.32.0.info"} {
+		require.NoError(t, os.WriteFile(filepath.Join(modCache, "cache/download/gofr.dev/@v", f), nil, 0600))
+	}
+
+	assert.ElementsMatch(t, []string{"v1.28.0", "v1.9.2", "v1.31.0"}, Versions(modCache, "gofr.dev"))
+	assert.Equal(t, []string{"v1.4.0"}, Versions(modCache, "github.com/BurntSushi/toml"))
+	assert.Empty(t, Versions("", "gofr.dev"))
+}
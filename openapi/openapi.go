@@ -0,0 +1,347 @@
+// Package openapi implements the `gofr openapi` commands, which generate the OpenAPI document GoFr serves
+// at /.well-known/openapi.json from the routes the app registers.
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+
+	"gofr.dev/cli/gofr/add"
+	"gofr.dev/cli/gofr/routes"
+)
+
+const (
+	openAPIFile    = "static/openapi.json"
+	openAPIVersion = "3.0.3"
+	contextType    = "*gofr.dev/pkg/gofr.Context"
+	jsonType       = "application/json"
+
+	fileMode = 0644
+	dirMode  = 0755
+)
+
+var errStale = errors.New(openAPIFile + " is out of date, run gofr openapi generate to update it")
+
+// exit ends the process with the status code, it is replaced in the tests.
+//
+//nolint:gochecknoglobals // replaced in the tests, which can not exit.
+var exit = os.Exit
+
+var pathParam = regexp.MustCompile(`{([^{}/:]+)(:[^{}/]*)?}`)
+
+type document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       info                             `json:"info"`
+	Paths      map[string]map[string]*operation `json:"paths"`
+	Components *components                      `json:"components,omitempty"`
+}
+
+type info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+type operation struct {
+	Parameters  []parameter         `json:"parameters,omitempty"`
+	RequestBody *requestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]response `json:"responses"`
+}
+
+type parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required,omitempty"`
+	Schema   *Schema `json:"schema"`
+}
+
+type requestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]mediaType `json:"content"`
+}
+
+type response struct {
+	Description string               `json:"description"`
+	Content     map[string]mediaType `json:"content,omitempty"`
+}
+
+type mediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Generate writes static/openapi.json describing the HTTP routes of the project in the current directory.
+// The paths and path parameters are taken from the route patterns, the query parameters from the calls of
+// ctx.Param, the request bodies from the types handlers bind and the responses from the types they return.
+// With -check the file is not written, instead the command exits with status 1 when it is out of date.
+func Generate(ctx *gofr.Context) (any, error) {
+	content, err := generate(".")
+	if err != nil {
+		return nil, err
+	}
+
+	if ctx.Param("check") == "true" {
+		err = check(openAPIFile, content)
+		if err != nil {
+			// GoFr exits with status 0 after printing the error of a command, CI needs the check to fail.
+			fmt.Fprintln(os.Stderr, err)
+			exit(1)
+
+			return nil, err
+		}
+
+		return openAPIFile + " is up to date", nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(openAPIFile), dirMode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.WriteFile(openAPIFile, content, fileMode)
+	if err != nil {
+		return nil, err
+	}
+
+	return "Successfully generated " + openAPIFile, nil
+}
+
+// check returns errStale unless the file exists with the content.
+func check(file string, content []byte) error {
+	existing, err := os.ReadFile(file)
+	if err != nil || !bytes.Equal(existing, content) {
+		return errStale
+	}
+
+	return nil
+}
+
+func generate(dir string) ([]byte, error) {
+	p, err := add.LoadProject(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	handlers, err := routes.Handlers(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := build(p, handlers)
+
+	content, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(content, '\n'), nil
+}
+
+func build(p *add.Project, handlers []routes.Handler) *document {
+	doc := &document{
+		OpenAPI: openAPIVersion,
+		Info:    info{Title: p.Name, Version: p.Env.GetOrDefault("APP_VERSION", "1.0.0")},
+		Paths:   make(map[string]map[string]*operation),
+	}
+
+	s := newSchemas()
+
+	for i := range handlers {
+		h := &handlers[i]
+
+		switch h.Method {
+		case "GET", "POST", "PUT", "PATCH", "DELETE":
+		default:
+			continue
+		}
+
+		if !strings.HasPrefix(h.Path, "/") {
+			continue
+		}
+
+		path := pathParam.ReplaceAllString(h.Path, "{$1}")
+
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]*operation)
+		}
+
+		doc.Paths[path][strings.ToLower(h.Method)] = newOperation(s, h)
+	}
+
+	if len(s.components) > 0 {
+		doc.Components = &components{Schemas: s.components}
+	}
+
+	return doc
+}
+
+func newOperation(s *schemas, h *routes.Handler) *operation {
+	op := &operation{}
+
+	for _, m := range pathParam.FindAllStringSubmatch(h.Path, -1) {
+		op.Parameters = append(op.Parameters, parameter{Name: m[1], In: "path", Required: true, Schema: &Schema{Type: "string"}})
+	}
+
+	var request, result types.Type
+
+	if h.Entity != nil {
+		request, result = restTypes(h)
+	} else if h.Body != nil && h.Info != nil {
+		request, result = bindType(h.Body, h.Info), resultType(h.Body, h.Info)
+
+		for _, name := range queryParams(h.Body, h.Info) {
+			op.Parameters = append(op.Parameters, parameter{Name: name, In: "query", Schema: &Schema{Type: "string"}})
+		}
+	}
+
+	if request != nil {
+		op.RequestBody = &requestBody{Required: true, Content: map[string]mediaType{jsonType: {Schema: s.schema(request)}}}
+	}
+
+	// GoFr responds to POST with 201 Created and to DELETE with 204 No Content, wrapping the data in {"data": ...}.
+	switch {
+	case h.Method == "DELETE":
+		op.Responses = map[string]response{"204": {Description: "No Content"}}
+	case h.Method == "POST":
+		op.Responses = map[string]response{"201": dataResponse(s, "Created", result)}
+	default:
+		op.Responses = map[string]response{"200": dataResponse(s, "OK", result)}
+	}
+
+	return op
+}
+
+func dataResponse(s *schemas, description string, result types.Type) response {
+	data := &Schema{}
+	if result != nil {
+		data = s.schema(result)
+	}
+
+	return response{
+		Description: description,
+		Content: map[string]mediaType{
+			jsonType: {Schema: &Schema{Type: "object", Properties: map[string]*Schema{"data": data}}},
+		},
+	}
+}
+
+// restTypes returns the request and response types of the routes AddRESTHandlers registers for the entity.
+func restTypes(h *routes.Handler) (request, result types.Type) {
+	switch {
+	case h.Method == "GET" && !strings.Contains(h.Path, "{"):
+		return nil, types.NewSlice(h.Entity)
+	case h.Method == "POST" || h.Method == "PUT":
+		return h.Entity, h.Entity
+	default:
+		return nil, h.Entity
+	}
+}
+
+// bindType returns the type of the first value the handler binds the request body into with ctx.Bind.
+func bindType(body *ast.BlockStmt, info *types.Info) types.Type {
+	var t types.Type
+
+	inspect(body, info, func(call *ast.CallExpr, method string) {
+		if t == nil && method == "Bind" && len(call.Args) == 1 {
+			if ptr, ok := info.TypeOf(call.Args[0]).(*types.Pointer); ok {
+				t = ptr.Elem()
+			}
+		}
+	})
+
+	return t
+}
+
+// queryParams returns the names of the query parameters the handler reads with ctx.Param, sorted by name.
+func queryParams(body *ast.BlockStmt, info *types.Info) []string {
+	seen := make(map[string]bool)
+
+	var names []string
+
+	inspect(body, info, func(call *ast.CallExpr, method string) {
+		if method != "Param" || len(call.Args) != 1 {
+			return
+		}
+
+		tv, ok := info.Types[call.Args[0]]
+		if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+			return
+		}
+
+		if name := constant.StringVal(tv.Value); !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	})
+
+	sort.Strings(names)
+
+	return names
+}
+
+// resultType returns the type of the first non-nil result the handler returns, nil when it only returns nil
+// or values of interface types.
+func resultType(body *ast.BlockStmt, info *types.Info) types.Type {
+	var t types.Type
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			if t != nil || len(n.Results) != 2 {
+				return false
+			}
+
+			if rt := info.TypeOf(n.Results[0]); rt != nil && !types.IsInterface(rt) && rt != types.Typ[types.UntypedNil] {
+				t = rt
+			}
+
+			return false
+		}
+
+		return true
+	})
+
+	return t
+}
+
+// inspect calls fn for the calls of the methods of the *gofr.Context in body, outside of function literals.
+// Receivers without type information are assumed to be the context.
+func inspect(body *ast.BlockStmt, info *types.Info, fn func(call *ast.CallExpr, method string)) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		if t := info.TypeOf(sel.X); t == nil || t == types.Typ[types.Invalid] || types.TypeString(t, nil) == contextType {
+			fn(call, sel.Sel.Name)
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,111 @@
+package openapi
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/cmd"
+)
+
+//nolint:gochecknoglobals // test flag to regenerate the golden files.
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestGenerate(t *testing.T) {
+	got, err := generate(filepath.Join("testdata", "project"))
+	require.NoError(t, err)
+
+	golden := filepath.Join("testdata", "openapi.json")
+
+	if *update {
+		require.NoError(t, os.WriteFile(golden, got, fileMode))
+	}
+
+	want, err := os.ReadFile(golden)
+	require.NoError(t, err)
+
+	assert.Equal(t, string(want), string(got))
+}
+
+func TestCheck(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "openapi.json")
+	require.NoError(t, os.WriteFile(file, []byte("{}\n"), fileMode))
+
+	tests := []struct {
+		file    string
+		content string
+		err     error
+	}{
+		{file, "{}\n", nil},
+		{file, "{\"openapi\": \"3.0.3\"}\n", errStale},
+		{filepath.Join(t.TempDir(), "missing.json"), "{}\n", errStale},
+	}
+
+	for i, tc := range tests {
+		err := check(tc.file, []byte(tc.content))
+
+		assert.Equal(t, tc.err, err, "TEST[%d], Failed.\n%s", i, tc.file)
+	}
+}
+
+func TestGenerate_Check(t *testing.T) {
+	golden, err := os.ReadFile(filepath.Join("testdata", "openapi.json"))
+	require.NoError(t, err)
+
+	chdir(t, filepath.Join("testdata", "project"))
+
+	t.Cleanup(func() { _ = os.RemoveAll(filepath.Dir(openAPIFile)) })
+
+	var code int
+
+	exit = func(c int) { code = c }
+
+	t.Cleanup(func() { exit = os.Exit })
+
+	tests := []struct {
+		existing string
+		data     any
+		err      error
+		code     int
+	}{
+		{string(golden), openAPIFile + " is up to date", nil, 0},
+		{"{}\n", nil, errStale, 1},
+		{"", nil, errStale, 1},
+	}
+
+	for i, tc := range tests {
+		require.NoError(t, os.RemoveAll(filepath.Dir(openAPIFile)))
+
+		if tc.existing != "" {
+			require.NoError(t, os.MkdirAll(filepath.Dir(openAPIFile), dirMode))
+			require.NoError(t, os.WriteFile(openAPIFile, []byte(tc.existing), fileMode))
+		}
+
+		code = 0
+		ctx := &gofr.Context{Context: context.Background(), Request: cmd.NewRequest([]string{"openapi", "generate", "-check"})}
+
+		data, err := Generate(ctx)
+
+		assert.Equal(t, tc.data, data, "TEST[%d], Failed.\n%s", i, tc.existing)
+		assert.Equal(t, tc.err, err, "TEST[%d], Failed.\n%s", i, tc.existing)
+		assert.Equal(t, tc.code, code, "TEST[%d], Failed.\n%s", i, tc.existing)
+	}
+}
+
+// chdir changes the working directory to dir for the duration of the test.
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	require.NoError(t, os.Chdir(dir))
+
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+}
@@ -0,0 +1,168 @@
+package openapi
+
+import (
+	"go/types"
+	"reflect"
+	"strings"
+)
+
+// Schema is an OpenAPI schema object, limited to what can be inferred from Go types.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// schemas converts Go types into schemas, collecting the schemas of named struct types as components
+// referred to by their name.
+type schemas struct {
+	components map[string]*Schema
+	names      map[*types.TypeName]string
+}
+
+func newSchemas() *schemas {
+	return &schemas{components: make(map[string]*Schema), names: make(map[*types.TypeName]string)}
+}
+
+func (s *schemas) schema(t types.Type) *Schema {
+	switch t := t.(type) {
+	case *types.Named:
+		return s.named(t)
+	case *types.Alias:
+		return s.schema(types.Unalias(t))
+	case *types.Pointer:
+		return s.schema(t.Elem())
+	case *types.Basic:
+		return basicSchema(t)
+	case *types.Slice:
+		if b, ok := t.Elem().Underlying().(*types.Basic); ok && b.Kind() == types.Byte {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+
+		return &Schema{Type: "array", Items: s.schema(t.Elem())}
+	case *types.Array:
+		return &Schema{Type: "array", Items: s.schema(t.Elem())}
+	case *types.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.schema(t.Elem())}
+	case *types.Struct:
+		return s.object(t)
+	default:
+		// interfaces, like any, and types JSON can not represent accept any value.
+		return &Schema{}
+	}
+}
+
+// named returns the schema of a named type, a reference to a component for structs.
+func (s *schemas) named(t *types.Named) *Schema {
+	obj := t.Obj()
+
+	if obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time" {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return s.schema(t.Underlying())
+	}
+
+	name, ok := s.names[obj]
+	if !ok {
+		name = obj.Name()
+
+		// types of different packages sharing a name are told apart by their package.
+		if _, taken := s.components[name]; taken && obj.Pkg() != nil {
+			name = exported(obj.Pkg().Name()) + name
+		}
+
+		s.names[obj] = name
+		// the placeholder ends the recursion of types referring to themselves.
+		s.components[name] = &Schema{}
+		*s.components[name] = *s.object(st)
+	}
+
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// object returns the schema of a struct, with a property per field encoding/json encodes.
+func (s *schemas) object(st *types.Struct) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+
+		name, _, _ := strings.Cut(reflect.StructTag(st.Tag(i)).Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if f.Anonymous() && name == "" {
+			// the fields of embedded structs are promoted into the object.
+			if embedded, ok := deref(f.Type()).Underlying().(*types.Struct); ok {
+				for k, v := range s.object(embedded).Properties {
+					schema.Properties[k] = v
+				}
+
+				continue
+			}
+		}
+
+		if !f.Exported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name()
+		}
+
+		schema.Properties[name] = s.schema(f.Type())
+	}
+
+	return schema
+}
+
+func basicSchema(t *types.Basic) *Schema {
+	info := t.Info()
+
+	switch {
+	case info&types.IsBoolean != 0:
+		return &Schema{Type: "boolean"}
+	case info&types.IsString != 0:
+		return &Schema{Type: "string"}
+	case info&types.IsInteger != 0:
+		switch t.Kind() {
+		case types.Int64, types.Uint64:
+			return &Schema{Type: "integer", Format: "int64"}
+		case types.Int32, types.Uint32:
+			return &Schema{Type: "integer", Format: "int32"}
+		default:
+			return &Schema{Type: "integer"}
+		}
+	case info&types.IsFloat != 0:
+		if t.Kind() == types.Float32 {
+			return &Schema{Type: "number", Format: "float"}
+		}
+
+		return &Schema{Type: "number", Format: "double"}
+	default:
+		return &Schema{}
+	}
+}
+
+func deref(t types.Type) types.Type {
+	if ptr, ok := t.(*types.Pointer); ok {
+		return ptr.Elem()
+	}
+
+	return t
+}
+
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
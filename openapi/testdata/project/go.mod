@@ -0,0 +1,7 @@
+module example.com/shop
+
+go 1.22
+
+require gofr.dev v1.0.0
+
+replace gofr.dev => ./gofr
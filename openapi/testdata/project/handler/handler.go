@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"time"
+
+	"gofr.dev/pkg/gofr"
+)
+
+type Audit struct {
+	UpdatedBy string `json:"updated_by"`
+}
+
+type Item struct {
+	SKU      string  `json:"sku"`
+	Quantity int     `json:"quantity"`
+	Price    float64 `json:"price"`
+}
+
+type Order struct {
+	Audit
+
+	ID        int64     `json:"id"`
+	Items     []Item    `json:"items"`
+	Tags      []string  `json:"tags,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Paid      bool
+	Parent    *Order `json:"parent,omitempty"`
+	Secret    string `json:"-"`
+	note      string
+}
+
+func ListOrders(ctx *gofr.Context) (any, error) {
+	_, _ = ctx.Param("status"), ctx.Param("limit")
+
+	return []Order{}, nil
+}
+
+func CreateOrder(ctx *gofr.Context) (any, error) {
+	var order Order
+
+	if err := ctx.Bind(&order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func GetOrder(ctx *gofr.Context) (any, error) {
+	if ctx.PathParam("id") == "" {
+		return nil, nil
+	}
+
+	return &Order{note: "found"}, nil
+}
+
+func DeleteOrder(*gofr.Context) (any, error) { return nil, nil }
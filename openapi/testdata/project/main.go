@@ -0,0 +1,37 @@
+package main
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"example.com/shop/handler"
+)
+
+type Customer struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type Invoice struct {
+	InvoiceID int     `json:"invoice_id"`
+	Total     float64 `json:"total"`
+}
+
+func main() {
+	app := gofr.New()
+
+	app.GET("/health", func(*gofr.Context) (any, error) {
+		return map[string]string{"status": "UP"}, nil
+	})
+
+	app.GET("/orders", handler.ListOrders)
+	app.POST("/orders", handler.CreateOrder)
+	app.GET("/orders/{id:[0-9]+}", handler.GetOrder)
+	app.DELETE("/orders/{id}", handler.DeleteOrder)
+
+	_ = app.AddRESTHandlers(&Customer{})
+	_ = app.AddRESTHandlers(&Invoice{})
+
+	app.WebSocket("/ws", handler.DeleteOrder)
+
+	app.Run()
+}
@@ -0,0 +1,395 @@
+// Package routes implements the `gofr routes` command, which lists the routes a GoFr app registers by
+// analysing the source code of the project.
+package routes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/tools/go/packages"
+
+	"gofr.dev/pkg/gofr"
+)
+
+const appType = "gofr.dev/pkg/gofr.App"
+
+var (
+	errFormat   = errors.New("invalid format, supported formats are table and json")
+	errNoSource = errors.New("no Go packages found, please run the command from the root of a GoFr project")
+)
+
+var grpcRegister = regexp.MustCompile(`^Register(\w+)ServerWithGofr$`)
+
+// restMethods are the methods AddRESTHandlers registers, on the path of the entity or of one of its items.
+//
+//nolint:gochecknoglobals // routes of AddRESTHandlers in the order GoFr registers them.
+var restMethods = []struct {
+	method string
+	item   bool
+}{
+	{"POST", false}, {"GET", false}, {"GET", true}, {"PUT", true}, {"DELETE", true},
+}
+
+// Route is a route registered by the app.
+type Route struct {
+	// Method is the HTTP method, WS for websocket routes and GRPC for gRPC services.
+	Method string `json:"method"`
+	// Path is the path of the route, or the service name for gRPC services.
+	Path    string `json:"path"`
+	Handler string `json:"handler"`
+	// Location is the file, relative to the project root, and line of the registration, e.g. main.go:12.
+	Location string `json:"location"`
+
+	file string
+	line int
+}
+
+// List prints the routes registered by the packages of the project in the current directory as a table,
+// or as JSON with -format=json. The routes are found by type checking the packages, so routes registered
+// by helpers taking the app are found as well as the ones in main.go.
+func List(ctx *gofr.Context) (any, error) {
+	format := ctx.Param("format")
+	if format != "" && format != "table" && format != "json" {
+		return nil, fmt.Errorf("%w, got %q", errFormat, format)
+	}
+
+	routes, err := Find(".")
+	if err != nil {
+		return nil, err
+	}
+
+	if format == "json" {
+		out, jsonErr := json.MarshalIndent(routes, "", "  ")
+		if jsonErr != nil {
+			return nil, jsonErr
+		}
+
+		return string(out), nil
+	}
+
+	return table(routes), nil
+}
+
+// Handler is a route with the code handling it, for the analysis of its requests and responses.
+type Handler struct {
+	Route
+	// Body is the body of the handler function and Info the type information of the package declaring it.
+	// Body is nil when the handler is not declared in the project or registered by AddRESTHandlers.
+	Body *ast.BlockStmt
+	Info *types.Info
+	// Entity is the type of the entity the routes of AddRESTHandlers handle.
+	Entity types.Type
+
+	expr ast.Expr
+}
+
+// declaration is a function declared in the project.
+type declaration struct {
+	body *ast.BlockStmt
+	info *types.Info
+}
+
+// Find loads the packages below dir and returns the routes they register, ordered by their location.
+// Packages with type errors are analysed as far as they could be type checked.
+func Find(dir string) ([]Route, error) {
+	handlers, err := Handlers(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]Route, 0, len(handlers))
+
+	for i := range handlers {
+		routes = append(routes, handlers[i].Route)
+	}
+
+	return routes, nil
+}
+
+// Handlers is like Find, returning the handlers of the routes.
+func Handlers(dir string) ([]Handler, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// the dependencies are type checked from source, so that the analysis does not depend on the export
+	// data format of the installed Go toolchain.
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedDeps | packages.NeedSyntax |
+			packages.NeedTypes | packages.NeedTypesInfo,
+		Dir: absDir,
+	}
+
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkgs) == 0 {
+		return nil, errNoSource
+	}
+
+	handlers := make([]Handler, 0)
+	decls := make(map[types.Object]declaration)
+
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Syntax {
+			handlers = append(handlers, fileHandlers(absDir, pkg, f)...)
+
+			for _, d := range f.Decls {
+				if fn, ok := d.(*ast.FuncDecl); ok && pkg.TypesInfo != nil && pkg.TypesInfo.Defs[fn.Name] != nil {
+					decls[pkg.TypesInfo.Defs[fn.Name]] = declaration{body: fn.Body, info: pkg.TypesInfo}
+				}
+			}
+		}
+	}
+
+	for i := range handlers {
+		handlers[i].resolve(decls)
+	}
+
+	sort.SliceStable(handlers, func(i, j int) bool {
+		if handlers[i].file != handlers[j].file {
+			return handlers[i].file < handlers[j].file
+		}
+
+		return handlers[i].line < handlers[j].line
+	})
+
+	return handlers, nil
+}
+
+// resolve sets the body of the handler, a function literal or a function or method declared in the project.
+func (h *Handler) resolve(decls map[types.Object]declaration) {
+	var ident *ast.Ident
+
+	switch expr := h.expr.(type) {
+	case *ast.FuncLit:
+		h.Body = expr.Body
+		return
+	case *ast.Ident:
+		ident = expr
+	case *ast.SelectorExpr:
+		ident = expr.Sel
+	default:
+		return
+	}
+
+	if h.Info == nil {
+		return
+	}
+
+	if d, ok := decls[h.Info.Uses[ident]]; ok {
+		h.Body, h.Info = d.body, d.info
+	} else {
+		h.Info = nil
+	}
+}
+
+func fileHandlers(dir string, pkg *packages.Package, f *ast.File) []Handler {
+	var handlers []Handler
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		pos := pkg.Fset.Position(call.Pos())
+
+		file, err := filepath.Rel(dir, pos.Filename)
+		if err != nil {
+			file = pos.Filename
+		}
+
+		file = filepath.ToSlash(file)
+
+		for _, h := range callRoutes(pkg.TypesInfo, call) {
+			h.file, h.line = file, pos.Line
+			h.Location = fmt.Sprintf("%s:%d", file, pos.Line)
+			h.Info = pkg.TypesInfo
+			handlers = append(handlers, h)
+		}
+
+		return true
+	})
+
+	return handlers
+}
+
+// callRoutes returns the routes registered by the call, if it is one of the methods of the app registering
+// routes or the registration of a gRPC service generated by gofr wrap grpc server.
+func callRoutes(info *types.Info, call *ast.CallExpr) []Handler {
+	var (
+		name string
+		recv ast.Expr
+	)
+
+	switch fun := call.Fun.(type) {
+	case *ast.SelectorExpr:
+		name, recv = fun.Sel.Name, fun.X
+	case *ast.Ident:
+		name = fun.Name
+	default:
+		return nil
+	}
+
+	if m := grpcRegister.FindStringSubmatch(name); m != nil && len(call.Args) == 2 {
+		return []Handler{{Route: Route{Method: "GRPC", Path: m[1], Handler: types.ExprString(call.Args[1])}}}
+	}
+
+	if recv == nil || !isApp(info, recv) {
+		return nil
+	}
+
+	switch name {
+	case "GET", "POST", "PUT", "PATCH", "DELETE":
+		if len(call.Args) == 2 {
+			return []Handler{{Route: Route{Method: name, Path: stringValue(info, call.Args[0]), Handler: types.ExprString(call.Args[1])},
+				expr: call.Args[1]}}
+		}
+	case "WebSocket":
+		if len(call.Args) == 2 {
+			return []Handler{{Route: Route{Method: "WS", Path: stringValue(info, call.Args[0]), Handler: types.ExprString(call.Args[1])},
+				expr: call.Args[1]}}
+		}
+	case "AddRESTHandlers":
+		if len(call.Args) == 1 {
+			return restRoutes(info, call.Args[0])
+		}
+	}
+
+	return nil
+}
+
+// restRoutes returns the routes AddRESTHandlers registers for the entity, named after its struct in lower case.
+// GoFr takes the first field of the struct as primary key, the path parameter of the items is its name in
+// lower case, e.g. /order/{orderid}.
+func restRoutes(info *types.Info, entity ast.Expr) []Handler {
+	var t types.Type
+	if info != nil {
+		t = info.TypeOf(entity)
+	}
+
+	name := strings.ToLower(typeName(t))
+	if name == "" {
+		name = "{" + types.ExprString(entity) + "}"
+	}
+
+	key := strings.ToLower(primaryKey(t))
+	if key == "" {
+		key = "id"
+	}
+
+	handler := "AddRESTHandlers(" + types.ExprString(entity) + ")"
+	handlers := make([]Handler, 0, len(restMethods))
+
+	for _, m := range restMethods {
+		path := "/" + name
+		if m.item {
+			path += "/{" + key + "}"
+		}
+
+		handlers = append(handlers, Handler{Route: Route{Method: m.method, Path: path, Handler: handler}, Entity: t})
+	}
+
+	return handlers
+}
+
+// isApp reports whether expr is a *gofr.App. Expressions without type information, e.g. as the package
+// has type errors, are assumed to be one.
+func isApp(info *types.Info, expr ast.Expr) bool {
+	if info == nil {
+		return true
+	}
+
+	t := info.TypeOf(expr)
+	if t == nil || t == types.Typ[types.Invalid] {
+		return true
+	}
+
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	return types.TypeString(t, nil) == appType
+}
+
+// primaryKey returns the name of the first field of the struct t points to, empty when it is not a struct.
+func primaryKey(t types.Type) string {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	if t == nil {
+		return ""
+	}
+
+	if s, ok := t.Underlying().(*types.Struct); ok && s.NumFields() > 0 {
+		return s.Field(0).Name()
+	}
+
+	return ""
+}
+
+func typeName(t types.Type) string {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	if named, ok := t.(*types.Named); ok {
+		return named.Obj().Name()
+	}
+
+	return ""
+}
+
+// stringValue returns the value of a constant string expression, or the expression itself.
+func stringValue(info *types.Info, expr ast.Expr) string {
+	if info != nil {
+		if tv, ok := info.Types[expr]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+			return constant.StringVal(tv.Value)
+		}
+	}
+
+	if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+		if value, err := strconv.Unquote(lit.Value); err == nil {
+			return value
+		}
+	}
+
+	return types.ExprString(expr)
+}
+
+func table(routes []Route) string {
+	if len(routes) == 0 {
+		return "No routes found"
+	}
+
+	var buf bytes.Buffer
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "METHOD\tPATH\tHANDLER\tLOCATION")
+
+	for _, r := range routes {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Method, r.Path, r.Handler, r.Location)
+	}
+
+	w.Flush()
+
+	return strings.TrimRight(buf.String(), "\n")
+}
@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFind(t *testing.T) {
+	routes, err := Find("testdata/project")
+	require.NoError(t, err)
+
+	rest := "AddRESTHandlers(&Customer{})"
+	invoice := "AddRESTHandlers(&Invoice{})"
+
+	assert.Equal(t, []Route{
+		{Method: "PUT", Path: "/orders/{id}/items", Handler: "CreateOrder", Location: "handler/handler.go:17"},
+		{Method: "DELETE", Path: "/orders/{id}", Handler: "CreateOrder", Location: "handler/handler.go:18"},
+		{Method: "GET", Path: "/ping", Handler: "(func(*gofr.Context) (any, error) literal)", Location: "main.go:25"},
+		{Method: "POST", Path: "/orders", Handler: "handler.CreateOrder", Location: "main.go:26"},
+		{Method: "POST", Path: "/customer", Handler: rest, Location: "main.go:30"},
+		{Method: "GET", Path: "/customer", Handler: rest, Location: "main.go:30"},
+		{Method: "GET", Path: "/customer/{id}", Handler: rest, Location: "main.go:30"},
+		{Method: "PUT", Path: "/customer/{id}", Handler: rest, Location: "main.go:30"},
+		{Method: "DELETE", Path: "/customer/{id}", Handler: rest, Location: "main.go:30"},
+		{Method: "POST", Path: "/invoice", Handler: invoice, Location: "main.go:31"},
+		{Method: "GET", Path: "/invoice", Handler: invoice, Location: "main.go:31"},
+		{Method: "GET", Path: "/invoice/{invoiceid}", Handler: invoice, Location: "main.go:31"},
+		{Method: "PUT", Path: "/invoice/{invoiceid}", Handler: invoice, Location: "main.go:31"},
+		{Method: "DELETE", Path: "/invoice/{invoiceid}", Handler: invoice, Location: "main.go:31"},
+		{Method: "WS", Path: "/ws", Handler: "handler.Chat", Location: "main.go:33"},
+		{Method: "GRPC", Path: "OrderService", Handler: "&server.OrderServer{}", Location: "main.go:35"},
+	}, stripPositions(routes))
+}
+
+func TestTable(t *testing.T) {
+	routes := []Route{
+		{Method: "GET", Path: "/ping", Handler: "handler.Ping", Location: "main.go:9"},
+		{Method: "DELETE", Path: "/orders/{id}", Handler: "handler.DeleteOrder", Location: "main.go:10"},
+	}
+
+	assert.Equal(t, "METHOD  PATH          HANDLER              LOCATION\n"+
+		"GET     /ping         handler.Ping         main.go:9\n"+
+		"DELETE  /orders/{id}  handler.DeleteOrder  main.go:10", table(routes))
+	assert.Equal(t, "No routes found", table(nil))
+}
+
+func stripPositions(routes []Route) []Route {
+	for i := range routes {
+		routes[i].file, routes[i].line = "", 0
+	}
+
+	return routes
+}
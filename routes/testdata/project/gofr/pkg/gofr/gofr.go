@@ -0,0 +1,19 @@
+// Package gofr is the part of the API of gofr.dev/pkg/gofr the routes test project uses.
+package gofr
+
+type Context struct{}
+
+type Handler func(*Context) (any, error)
+
+type App struct{}
+
+func New() *App { return &App{} }
+
+func (*App) GET(string, Handler)                    {}
+func (*App) POST(string, Handler)                   {}
+func (*App) PUT(string, Handler)                    {}
+func (*App) DELETE(string, Handler)                 {}
+func (*App) WebSocket(string, Handler)              {}
+func (*App) AddRESTHandlers(any) error              { return nil }
+func (*App) Subscribe(string, func(*Context) error) {}
+func (*App) Run()                                   {}
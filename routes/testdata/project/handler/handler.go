@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"gofr.dev/pkg/gofr"
+)
+
+type client struct{}
+
+func (client) GET(string, gofr.Handler) {}
+
+func CreateOrder(*gofr.Context) (any, error) { return nil, nil }
+
+func Chat(*gofr.Context) (any, error) { return nil, nil }
+
+// Register adds the routes of the order items, the app is not necessarily called app.
+func Register(a *gofr.App) {
+	a.PUT("/orders/{id}/items", CreateOrder)
+	a.DELETE("/orders/{id}", CreateOrder)
+
+	// the GET of other types is no route.
+	client{}.GET("/orders", CreateOrder)
+}
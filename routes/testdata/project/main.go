@@ -0,0 +1,40 @@
+package main
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"example.com/shop/handler"
+	"example.com/shop/server"
+)
+
+const ordersPath = "/orders"
+
+type Customer struct {
+	ID   int
+	Name string
+}
+
+type Invoice struct {
+	InvoiceID int
+	Total     float64
+}
+
+func main() {
+	app := gofr.New()
+
+	app.GET("/ping", func(*gofr.Context) (any, error) { return "pong", nil })
+	app.POST(ordersPath, handler.CreateOrder)
+
+	handler.Register(app)
+
+	_ = app.AddRESTHandlers(&Customer{})
+	_ = app.AddRESTHandlers(&Invoice{})
+
+	app.WebSocket("/ws", handler.Chat)
+
+	server.RegisterOrderServiceServerWithGofr(app, &server.OrderServer{})
+
+	app.Subscribe("orders", func(*gofr.Context) error { return nil })
+
+	app.Run()
+}
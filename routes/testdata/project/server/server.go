@@ -0,0 +1,7 @@
+package server
+
+import "gofr.dev/pkg/gofr"
+
+type OrderServer struct{}
+
+func RegisterOrderServiceServerWithGofr(*gofr.App, *OrderServer) {}
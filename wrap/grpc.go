@@ -5,6 +5,7 @@ import (
 	"errors"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -119,6 +120,50 @@ func generateWrapper(ctx *gofr.Context, options ...FileType) (any, error) {
 	return "Successfully generated all files for GoFr integrated gRPC servers/clients", nil
 }
 
+// ServerWrappers renders the files gofr wrap grpc server generates next to the proto file, except the one of the
+// server implementation, keyed by their name. gofr init uses it to create gRPC projects which build right away.
+func ServerWrappers(source string, content []byte) (map[string][]byte, error) {
+	definition, err := proto.NewParser(bytes.NewReader(content)).Parse()
+	if err != nil {
+		return nil, ErrFailedToParseProto
+	}
+
+	services := protoServices(definition)
+	files := make(map[string][]byte)
+
+	for _, service := range services {
+		data := WrapperData{
+			Package:  goPackage(definition),
+			Service:  service.Name,
+			Methods:  service.Methods,
+			Requests: requestTypes(service.Methods, false),
+			Source:   source,
+		}
+
+		for _, f := range []struct {
+			name string
+			tmpl string
+		}{
+			{strings.ToLower(service.Name) + serverWrapperFileSuffix, wrapperTemplate},
+			{serverHealthFile, healthServerTemplate},
+			{serverRequestFile, messageTemplate},
+		} {
+			if f.name == serverRequestFile {
+				data.Requests = allRequestTypes(services)
+			}
+
+			code, renderErr := render(&data, f.tmpl)
+			if renderErr != nil {
+				return nil, renderErr
+			}
+
+			files[f.name] = []byte(code)
+		}
+	}
+
+	return files, nil
+}
+
 // parseProtoFile opens and parses the proto file.
 func parseProtoFile(ctx *gofr.Context, protoPath string) (*proto.Proto, error) {
 	file, err := os.Open(protoPath)
@@ -154,6 +199,13 @@ func generateFiles(ctx *gofr.Context, projectPath, serviceName string, wrapperDa
 		}
 
 		outputFilePath := getOutputFilePath(projectPath, serviceName, option.FileSuffix)
+
+		// the server file holds the implementation of the methods, regenerating the wrappers must not replace it.
+		if _, err := os.Stat(outputFilePath); err == nil && option.FileSuffix == serverFileSuffix {
+			ctx.Logger.Infof("Skipped the existing server implementation of service %s at %s", serviceName, outputFilePath)
+			continue
+		}
+
 		if err := os.WriteFile(outputFilePath, []byte(generatedCode), filePerm); err != nil {
 			ctx.Logger.Errorf("Failed to write file %s: %v", outputFilePath, err)
 			return ErrWritingFile
@@ -181,55 +233,78 @@ func getOutputFilePath(projectPath, serviceName, fileSuffix string) string {
 
 // getRequests extracts all unique request types from the services.
 func getRequests(ctx *gofr.Context, services []ProtoService) []string {
-	requests := make(map[string]bool)
+	requests := allRequestTypes(services)
+
+	ctx.Logger.Debugf("Extracted unique request types: %v", requests)
+
+	return requests
+}
+
+// allRequestTypes returns the unique request types of the methods of all the services.
+func allRequestTypes(services []ProtoService) []string {
+	var methods []ServiceMethod
 
 	for _, service := range services {
-		for _, method := range service.Methods {
-			requests[method.Request] = true
-		}
+		methods = append(methods, service.Methods...)
 	}
 
-	ctx.Logger.Debugf("Extracted unique request types: %v", requests)
-
-	return mapKeysToSlice(requests)
+	return requestTypes(methods, true)
 }
 
 // uniqueRequestTypes extracts unique request types from methods.
 func uniqueRequestTypes(ctx *gofr.Context, methods []ServiceMethod) []string {
+	requests := requestTypes(methods, false)
+
+	ctx.Logger.Debugf("Extracted unique request types for methods: %v", requests)
+
+	return requests
+}
+
+// requestTypes returns the unique request types of the methods, the ones of streaming methods only with streaming.
+func requestTypes(methods []ServiceMethod, streaming bool) []string {
 	requests := make(map[string]bool)
 
 	for _, method := range methods {
-		if !method.Streaming {
+		if streaming || !method.Streaming {
 			requests[method.Request] = true
 		}
 	}
 
-	ctx.Logger.Debugf("Extracted unique request types for methods: %v", requests)
-
 	return mapKeysToSlice(requests)
 }
 
-// mapKeysToSlice converts a map's keys to a slice.
+// mapKeysToSlice converts a map's keys to a sorted slice, so that the generated code does not change between runs.
 func mapKeysToSlice(m map[string]bool) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
 	}
 
+	sort.Strings(keys)
+
 	return keys
 }
 
 // executeTemplate executes a template with the provided data.
 func executeTemplate(ctx *gofr.Context, data *WrapperData, tmpl string) string {
+	code, err := render(data, tmpl)
+	if err != nil {
+		ctx.Logger.Errorf("Template execution failed: %v", err)
+		return ""
+	}
+
+	return code
+}
+
+func render(data *WrapperData, tmpl string) (string, error) {
 	var buf bytes.Buffer
 
 	tmplInstance := template.Must(template.New("template").Parse(tmpl))
 	if err := tmplInstance.Execute(&buf, data); err != nil {
-		ctx.Logger.Errorf("Template execution failed: %v", err)
-		return ""
+		return "", err
 	}
 
-	return buf.String()
+	return buf.String(), nil
 }
 
 // Template generators.
@@ -259,6 +334,17 @@ func generateGoFrClient(ctx *gofr.Context, data *WrapperData) string {
 
 // getPackageAndProject extracts the package name and project path from the proto definition.
 func getPackageAndProject(ctx *gofr.Context, definition *proto.Proto, protoPath string) (projectPath, packageName string) {
+	packageName = goPackage(definition)
+	projectPath = path.Dir(protoPath)
+	ctx.Logger.Debugf("Extracted package name: %s, project path: %s", packageName, projectPath)
+
+	return projectPath, packageName
+}
+
+// goPackage returns the name of the Go package of the proto definition, the last element of its go_package.
+func goPackage(definition *proto.Proto) string {
+	var packageName string
+
 	proto.Walk(definition,
 		proto.WithOption(func(opt *proto.Option) {
 			if opt.Name == "go_package" {
@@ -267,14 +353,20 @@ func getPackageAndProject(ctx *gofr.Context, definition *proto.Proto, protoPath
 		}),
 	)
 
-	projectPath = path.Dir(protoPath)
-	ctx.Logger.Debugf("Extracted package name: %s, project path: %s", packageName, projectPath)
-
-	return projectPath, packageName
+	return packageName
 }
 
 // getServices extracts services from the proto definition.
 func getServices(ctx *gofr.Context, definition *proto.Proto) []ProtoService {
+	services := protoServices(definition)
+
+	ctx.Logger.Debugf("Extracted services: %v", services)
+
+	return services
+}
+
+// protoServices returns the services of the proto definition with their methods.
+func protoServices(definition *proto.Proto) []ProtoService {
 	var services []ProtoService
 
 	proto.Walk(definition,
@@ -296,7 +388,5 @@ func getServices(ctx *gofr.Context, definition *proto.Proto) []ProtoService {
 		}),
 	)
 
-	ctx.Logger.Debugf("Extracted services: %v", services)
-
 	return services
 }